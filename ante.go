@@ -19,6 +19,7 @@ package noble
 import (
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
 	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory"
 	ftfkeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -32,6 +33,9 @@ import (
 	"github.com/noble-assets/forwarding/v2"
 	forwardingkeeper "github.com/noble-assets/forwarding/v2/keeper"
 	forwardingtypes "github.com/noble-assets/forwarding/v2/types"
+
+	"github.com/noble-assets/noble/v11/throttle"
+	permissionskeeper "github.com/noble-assets/noble/v11/x/permissions/keeper"
 )
 
 type BankKeeper interface {
@@ -43,12 +47,14 @@ type BankKeeper interface {
 // AnteHandler for our custom ante decorators.
 type HandlerOptions struct {
 	ante.HandlerOptions
-	cdc              codec.Codec
-	BankKeeper       BankKeeper
-	DollarKeeper     DollarKeeper
-	ForwardingKeeper *forwardingkeeper.Keeper
-	FTFKeeper        *ftfkeeper.Keeper
-	IBCKeeper        *ibckeeper.Keeper
+	cdc                codec.Codec
+	BankKeeper         BankKeeper
+	ForwardingKeeper   *forwardingkeeper.Keeper
+	ForwardingThrottle *throttle.Throttle
+	FTFKeeper          *ftfkeeper.Keeper
+	IBCKeeper          *ibckeeper.Keeper
+	PermissionsKeeper  *permissionskeeper.Keeper
+	WarpKeeper         *warpkeeper.Keeper
 }
 
 // NewAnteHandler extends the default Cosmos SDK AnteHandler with custom ante decorators.
@@ -61,14 +67,14 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
 	}
 
-	if options.DollarKeeper == nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "dollar keeper is required for ante builder")
-	}
-
 	if options.ForwardingKeeper == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "forwarding keeper is required for ante builder")
 	}
 
+	if options.ForwardingThrottle == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "forwarding throttle is required for ante builder")
+	}
+
 	if options.FTFKeeper == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "fiattokenfactory keeper is required for ante builder")
 	}
@@ -77,28 +83,40 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "ibc keeper is required for ante builder")
 	}
 
+	if options.PermissionsKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "permissions keeper is required for ante builder")
+	}
+
+	if options.WarpKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "warp keeper is required for ante builder")
+	}
+
 	if options.SignModeHandler == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		NewInjectedTxDecorator(),        // injected txs are skipped before any other checks
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 
+		NewCircuitBreakerDecorator(options.PermissionsKeeper),
+
 		fiattokenfactory.NewIsPausedDecorator(options.cdc, options.FTFKeeper),
 		fiattokenfactory.NewIsBlacklistedDecorator(options.FTFKeeper),
 
-		NewPermissionedHyperlaneDecorator(options.DollarKeeper),
-		NewPermissionedLiquidityDecorator(),
+		NewPermissionedMessagesDecorator(options.PermissionsKeeper),
+		NewRecipientScreeningDecorator(NewRecipientScreener(options.FTFKeeper), options.WarpKeeper),
 
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		NewForwardingThrottleDecorator(options.ForwardingThrottle),
 
 		NewSigVerificationDecorator(options),
 
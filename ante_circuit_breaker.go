@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ sdk.AnteDecorator = &CircuitBreakerDecorator{}
+
+// CircuitBreakerDecorator is a custom ante handler that halts the execution
+// of message types whose circuit breaker has been tripped by the authority.
+type CircuitBreakerDecorator struct {
+	circuitBreakerKeeper CircuitBreakerKeeper
+}
+
+// CircuitBreakerKeeper defines the interface expected by CircuitBreakerDecorator for the Noble Permissions module.
+type CircuitBreakerKeeper interface {
+	IsTripped(ctx context.Context, typeUrl string) (bool, error)
+}
+
+func NewCircuitBreakerDecorator(circuitBreakerKeeper CircuitBreakerKeeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitBreakerKeeper: circuitBreakerKeeper,
+	}
+}
+
+func (d CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for index, msg := range tx.GetMsgs() {
+		err := d.CheckMessage(ctx, msg)
+		if err != nil {
+			return ctx, NewMessageError(index, sdk.MsgTypeURL(msg), err)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// CheckMessage ensures that the circuit breaker of a message, including all
+// messages nested inside an authz execution, isn't tripped.
+func (d CircuitBreakerDecorator) CheckMessage(ctx sdk.Context, msg sdk.Msg) error {
+	typeUrl := sdk.MsgTypeURL(msg)
+
+	tripped, err := d.circuitBreakerKeeper.IsTripped(ctx, typeUrl)
+	if err != nil {
+		return err
+	}
+	if tripped {
+		return errorsmod.Wrapf(ErrCircuitBreakerTripped, "%s is currently halted", typeUrl)
+	}
+
+	if m, ok := msg.(*authz.MsgExec); ok {
+		execMsgs, err := m.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		for index, execMsg := range execMsgs {
+			err = d.CheckMessage(ctx, execMsg)
+			if err != nil {
+				return NewMessageError(index, sdk.MsgTypeURL(execMsg), err)
+			}
+		}
+	}
+
+	return nil
+}
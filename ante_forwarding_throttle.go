@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	forwardingtypes "github.com/noble-assets/forwarding/v2/types"
+
+	"github.com/noble-assets/noble/v11/throttle"
+)
+
+var _ sdk.AnteDecorator = &ForwardingThrottleDecorator{}
+
+// ForwardingThrottleDecorator is a custom ante handler that throttles
+// transactions signed by forwarding accounts in CheckTx. As these don't
+// require a signature, and are therefore free of signature gas, they are
+// charged a flat amount of gas and limited per account and per block.
+type ForwardingThrottleDecorator struct {
+	throttle *throttle.Throttle
+}
+
+func NewForwardingThrottleDecorator(throttle *throttle.Throttle) ForwardingThrottleDecorator {
+	return ForwardingThrottleDecorator{throttle: throttle}
+}
+
+func (d ForwardingThrottleDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// Simulations are handled like CheckTx, so that the flat gas is included
+	// in gas estimates, but they aren't recorded by the throttle.
+	if (!ctx.IsCheckTx() && !simulate) || ctx.IsReCheckTx() || !d.throttle.Config().Enabled {
+		return next(ctx, tx, simulate)
+	}
+
+	accounts, err := GetForwardingSigners(tx)
+	if err != nil {
+		return ctx, err
+	}
+	if len(accounts) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	ctx.GasMeter().ConsumeGas(d.throttle.Config().Gas, "forwarding account transaction")
+
+	for _, account := range accounts {
+		if err := d.throttle.Check(ctx.BlockHeight(), account); err != nil {
+			return ctx, errorsmod.Wrap(ErrForwardingThrottled, err.Error())
+		}
+	}
+
+	newCtx, err = next(ctx, tx, simulate)
+	if err != nil || simulate {
+		return newCtx, err
+	}
+
+	for _, account := range accounts {
+		d.throttle.Record(ctx.BlockHeight(), account)
+	}
+
+	return newCtx, nil
+}
+
+// GetForwardingSigners returns the addresses of all signers of a transaction
+// that sign using a forwarding account public key.
+func GetForwardingSigners(tx sdk.Tx) ([]string, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []string
+	for _, sig := range sigs {
+		if _, ok := sig.PubKey.(*forwardingtypes.ForwardingPubKey); ok {
+			accounts = append(accounts, sdk.AccAddress(sig.PubKey.Address()).String())
+		}
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	forwardingtypes "github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v11/throttle"
+)
+
+func TestForwardingThrottleDecorator(t *testing.T) {
+	cfg := moduletestutil.MakeTestEncodingConfig()
+
+	builder := cfg.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey: &forwardingtypes.ForwardingPubKey{Key: sdk.AccAddress("forwarding")},
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+	}))
+	tx := builder.GetTx()
+
+	config := throttle.DefaultConfig()
+	config.MaxPerWindow = 1
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	tests := []struct {
+		name     string
+		checkTx  bool
+		simulate bool
+		gas      uint64
+	}{
+		{name: "deliver", checkTx: false, simulate: false, gas: 0},
+		{name: "simulate", checkTx: false, simulate: true, gas: config.Gas},
+		{name: "check", checkTx: true, simulate: false, gas: config.Gas},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			decorator := NewForwardingThrottleDecorator(throttle.New(config))
+			ctx := sdk.Context{}.
+				WithIsCheckTx(tc.checkTx).
+				WithBlockHeight(1).
+				WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			_, err := decorator.AnteHandle(ctx, tx, tc.simulate, next)
+			require.NoError(t, err)
+			require.Equal(t, tc.gas, ctx.GasMeter().GasConsumed())
+
+			// Only transactions accepted in CheckTx are recorded, and
+			// therefore count towards the limit of the account.
+			_, err = decorator.AnteHandle(ctx, tx, tc.simulate, next)
+			if tc.checkTx {
+				require.ErrorIs(t, err, ErrForwardingThrottled)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
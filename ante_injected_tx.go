@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	injectiontypes "github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+var _ sdk.AnteDecorator = &InjectedTxDecorator{}
+
+// InjectedTxDecorator is a custom ante handler that skips txs injected into a
+// block proposal by the proposer, as they are unsigned and only processed in
+// the PreBlocker. It also rejects marked txs submitted to the mempool, or
+// included anywhere else in a block.
+type InjectedTxDecorator struct{}
+
+func NewInjectedTxDecorator() InjectedTxDecorator {
+	return InjectedTxDecorator{}
+}
+
+func (d InjectedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if marker, ok := injectiontypes.GetInjectedTx(tx); ok {
+		return ctx, errorsmod.Wrapf(ErrInjectedTx, "injected by %s", marker.Injector)
+	}
+
+	return next(ctx, tx, simulate)
+}
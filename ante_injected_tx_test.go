@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	injectiontypes "github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+func TestInjectedTxDecorator(t *testing.T) {
+	cfg := moduletestutil.MakeTestEncodingConfig()
+	decorator := NewInjectedTxDecorator()
+
+	var called bool
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		called = true
+		return ctx, nil
+	}
+
+	for _, simulate := range []bool{false, true} {
+		// ARRANGE: A regular tx.
+		called = false
+		tx := cfg.TxConfig.NewTxBuilder().GetTx()
+		// ACT: Attempt to run the decorator.
+		_, err := decorator.AnteHandle(sdk.Context{}, tx, simulate, next)
+		// ASSERT: The tx is passed on.
+		require.NoError(t, err)
+		require.True(t, called)
+
+		// ARRANGE: A tx marked as injected.
+		called = false
+		builder := cfg.TxConfig.NewTxBuilder()
+		require.NoError(t, injectiontypes.MarkInjectedTx(builder, "dollar", nil))
+		// ACT: Attempt to run the decorator.
+		_, err = decorator.AnteHandle(sdk.Context{}, builder.GetTx(), simulate, next)
+		// ASSERT: The tx is rejected.
+		require.ErrorIs(t, err, ErrInjectedTx)
+		require.ErrorContains(t, err, "injected by dollar")
+		require.False(t, called)
+	}
+}
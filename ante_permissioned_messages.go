@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	permissionstypes "github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+var _ sdk.AnteDecorator = &PermissionedMessagesDecorator{}
+
+// PermissionedMessagesDecorator is a custom ante handler that enforces the
+// on-chain message permission policies of Noble.
+type PermissionedMessagesDecorator struct {
+	permissionsKeeper PermissionsKeeper
+}
+
+// PermissionsKeeper defines the interface expected by PermissionedMessagesDecorator for the Noble Permissions module.
+type PermissionsKeeper interface {
+	CheckMessage(ctx context.Context, msg sdk.Msg) (*permissionstypes.Violation, error)
+	CanCreateCollateral(ctx context.Context, denom string, creator string) (bool, error)
+}
+
+func NewPermissionedMessagesDecorator(permissionsKeeper PermissionsKeeper) PermissionedMessagesDecorator {
+	return PermissionedMessagesDecorator{
+		permissionsKeeper: permissionsKeeper,
+	}
+}
+
+func (d PermissionedMessagesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for index, msg := range tx.GetMsgs() {
+		err := d.CheckMessage(ctx, msg)
+		if err != nil {
+			return ctx, NewMessageError(index, sdk.MsgTypeURL(msg), err)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// CheckMessage ensures that a message, including all messages nested inside
+// an authz execution, is allowed by the on-chain permission policies and
+// doesn't use a protected denom as Hyperlane collateral without consent.
+func (d PermissionedMessagesDecorator) CheckMessage(ctx sdk.Context, msg sdk.Msg) error {
+	violation, err := d.permissionsKeeper.CheckMessage(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if violation != nil {
+		return errorsmod.Wrap(ErrPermissionedAction, violation.String())
+	}
+
+	switch m := msg.(type) {
+	case *warptypes.MsgCreateCollateralToken:
+		allowed, err := d.permissionsKeeper.CanCreateCollateral(ctx, m.OriginDenom, m.Owner)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return errorsmod.Wrapf(ErrForbiddenCollateralDenom, "cannot create hyperlane collateral token for denom %s", m.OriginDenom)
+		}
+	case *authz.MsgExec:
+		execMsgs, err := m.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		for index, execMsg := range execMsgs {
+			err = d.CheckMessage(ctx, execMsg)
+			if err != nil {
+				return errorsmod.Wrapf(ErrNestedAuthzViolation, "message %d (%s): %s", index, sdk.MsgTypeURL(execMsg), err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"fmt"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	permissionstypes "github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+func TestNestedAuthzViolation(t *testing.T) {
+	k, ctx := setupKeepers(t)
+
+	alice := sdk.AccAddress("alice")
+	bob := sdk.AccAddress("bob")
+	sendTypeUrl := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	require.NoError(t, k.permissionsKeeper.Policies.Set(ctx, sendTypeUrl, permissionstypes.Policy{
+		TypeUrl: sendTypeUrl,
+		Action:  permissionstypes.ActionDeny,
+	}))
+
+	send := banktypes.NewMsgSend(alice, bob, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	exec := authz.NewMsgExec(bob, []sdk.Msg{banktypes.NewMsgMultiSend(banktypes.Input{Address: alice.String()}, nil), send})
+
+	err := NewPermissionedMessagesDecorator(k.permissionsKeeper).CheckMessage(ctx, &exec)
+	require.ErrorIs(t, err, ErrNestedAuthzViolation)
+	require.ErrorContains(t, err, fmt.Sprintf("message 1 (%s)", sendTypeUrl))
+	require.ErrorContains(t, err, ErrPermissionedAction.Error())
+
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	require.Equal(t, Codespace, codespace)
+	require.Equal(t, ErrNestedAuthzViolation.ABCICode(), code)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	forwardingtypes "github.com/noble-assets/forwarding/v2/types"
+)
+
+var _ sdk.AnteDecorator = &RecipientScreeningDecorator{}
+
+// RecipientScreeningDecorator is a custom ante handler that ensures that no
+// blacklisted party is reachable through a cross-chain hop initiated on Noble.
+type RecipientScreeningDecorator struct {
+	screener   RecipientScreener
+	warpKeeper *warpkeeper.Keeper
+}
+
+func NewRecipientScreeningDecorator(screener RecipientScreener, warpKeeper *warpkeeper.Keeper) RecipientScreeningDecorator {
+	return RecipientScreeningDecorator{
+		screener:   screener,
+		warpKeeper: warpKeeper,
+	}
+}
+
+func (d RecipientScreeningDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for index, msg := range tx.GetMsgs() {
+		err := d.CheckMessage(ctx, msg)
+		if err != nil {
+			return ctx, NewMessageError(index, sdk.MsgTypeURL(msg), err)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// CheckMessage ensures that no beneficiary of a message, including all
+// messages nested inside an authz execution, is blacklisted.
+func (d RecipientScreeningDecorator) CheckMessage(ctx sdk.Context, msg sdk.Msg) error {
+	switch m := msg.(type) {
+	case *transfertypes.MsgTransfer:
+		// The receiver itself is already checked by the Fiat TokenFactory.
+		if d.screener.IsMintingDenom(ctx, m.Token.Denom) {
+			return d.screener.ScreenMemo(ctx, m.Memo)
+		}
+	case *forwardingtypes.MsgRegisterAccount:
+		// Forwarding accounts forward all received tokens, so the recipient
+		// is screened regardless of the denom.
+		return d.screener.ScreenRecipient(ctx, m.Recipient)
+	case *warptypes.MsgRemoteTransfer:
+		token, err := d.warpKeeper.HypTokens.Get(ctx, m.TokenId.GetInternalId())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		if d.screener.IsMintingDenom(ctx, token.OriginDenom) {
+			return d.screener.ScreenRecipientBytes(ctx, m.Recipient.Bytes())
+		}
+	case *authz.MsgExec:
+		execMsgs, err := m.GetMessages()
+		if err != nil {
+			return err
+		}
+
+		for index, execMsg := range execMsgs {
+			err = d.CheckMessage(ctx, execMsg)
+			if err != nil {
+				return NewMessageError(index, sdk.MsgTypeURL(execMsg), err)
+			}
+		}
+	}
+
+	return nil
+}
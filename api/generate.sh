@@ -19,6 +19,7 @@ buf export buf.build/noble-assets/orbiter:v2.0.0 --output api/proto
 buf export buf.build/noble-assets/rate-limiting:v8.0.0 --output api/proto
 buf export buf.build/noble-assets/swap:v1.0.2 --output api/proto
 buf export buf.build/noble-assets/wormhole:v1.0.0 --output api/proto
+buf export ./proto --exclude-imports --output api/proto
 
 buf generate
 swagger-combine ./api/config.json -o ./api/gen/swagger.yaml -f yaml --includeDefinitions true
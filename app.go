@@ -17,6 +17,7 @@
 package noble
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	"io"
@@ -47,8 +48,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/noble-assets/noble/v11/api"
 	"github.com/noble-assets/noble/v11/jester"
+	"github.com/noble-assets/noble/v11/preflight"
+	"github.com/noble-assets/noble/v11/throttle"
 	"github.com/noble-assets/noble/v11/upgrade"
-	"github.com/spf13/cast"
 
 	_ "cosmossdk.io/x/evidence"
 	_ "cosmossdk.io/x/feegrant/module"
@@ -70,8 +72,10 @@ import (
 	_ "github.com/monerium/module-noble/v2"
 	_ "github.com/noble-assets/authority"
 	_ "github.com/noble-assets/forwarding/v2"
-	"github.com/noble-assets/globalfee"
 	_ "github.com/noble-assets/halo/v2"
+	"github.com/noble-assets/noble/v11/x/feepolicy"
+	_ "github.com/noble-assets/noble/v11/x/injection"
+	_ "github.com/noble-assets/noble/v11/x/permissions"
 	_ "github.com/noble-assets/orbiter/v2"
 	_ "github.com/noble-assets/wormhole"
 	_ "github.com/ondoprotocol/usdy-noble/v2"
@@ -126,6 +130,9 @@ import (
 	authoritykeeper "github.com/noble-assets/authority/keeper"
 	forwardingkeeper "github.com/noble-assets/forwarding/v2/keeper"
 	globalfeekeeper "github.com/noble-assets/globalfee/keeper"
+	feepolicykeeper "github.com/noble-assets/noble/v11/x/feepolicy/keeper"
+	injectionkeeper "github.com/noble-assets/noble/v11/x/injection/keeper"
+	permissionskeeper "github.com/noble-assets/noble/v11/x/permissions/keeper"
 	orbiterkeeper "github.com/noble-assets/orbiter/v2/keeper"
 	wormholekeeper "github.com/noble-assets/wormhole/keeper"
 	swapkeeper "swap.noble.xyz/keeper"
@@ -181,13 +188,16 @@ type App struct {
 	// Monerium Modules
 	FlorinKeeper *florinkeeper.Keeper
 	// Noble Modules
-	AuthorityKeeper  *authoritykeeper.Keeper
-	DollarKeeper     *dollarkeeper.Keeper
-	ForwardingKeeper *forwardingkeeper.Keeper
-	GlobalFeeKeeper  *globalfeekeeper.Keeper
-	OrbiterKeeper    *orbiterkeeper.Keeper
-	SwapKeeper       *swapkeeper.Keeper
-	WormholeKeeper   *wormholekeeper.Keeper
+	AuthorityKeeper   *authoritykeeper.Keeper
+	DollarKeeper      *dollarkeeper.Keeper
+	FeePolicyKeeper   *feepolicykeeper.Keeper
+	ForwardingKeeper  *forwardingkeeper.Keeper
+	GlobalFeeKeeper   *globalfeekeeper.Keeper
+	InjectionKeeper   *injectionkeeper.Keeper
+	OrbiterKeeper     *orbiterkeeper.Keeper
+	PermissionsKeeper *permissionskeeper.Keeper
+	SwapKeeper        *swapkeeper.Keeper
+	WormholeKeeper    *wormholekeeper.Keeper
 }
 
 func init() {
@@ -266,9 +276,12 @@ func NewApp(
 		// Noble Modules
 		&app.AuthorityKeeper,
 		&app.DollarKeeper,
+		&app.FeePolicyKeeper,
 		&app.ForwardingKeeper,
 		&app.GlobalFeeKeeper,
+		&app.InjectionKeeper,
 		&app.OrbiterKeeper,
+		&app.PermissionsKeeper,
 		&app.SwapKeeper,
 		&app.WormholeKeeper,
 	); err != nil {
@@ -279,6 +292,10 @@ func NewApp(
 
 	app.RegisterOrbiterControllers()
 
+	app.PermissionsKeeper.SetIssuerKeepers(IssuerKeepers(
+		app.FTFKeeper, app.AuraKeeper, app.HaloKeeper, app.FlorinKeeper, app.DollarKeeper,
+	))
+
 	if err := app.RegisterLegacyModules(); err != nil {
 		return nil, err
 	}
@@ -295,28 +312,58 @@ func NewApp(
 			AccountKeeper:   app.AccountKeeper,
 			FeegrantKeeper:  app.FeeGrantKeeper,
 			SignModeHandler: app.txConfig.SignModeHandler(),
-			TxFeeChecker:    globalfee.TxFeeChecker(app.GlobalFeeKeeper),
+			TxFeeChecker:    feepolicy.TxFeeChecker(app.FeePolicyKeeper, app.GlobalFeeKeeper),
 			SigGasConsumer:  SigVerificationGasConsumer,
 		},
-		cdc:              app.appCodec,
-		BankKeeper:       app.BankKeeper,
-		DollarKeeper:     app.DollarKeeper,
-		ForwardingKeeper: app.ForwardingKeeper,
-		FTFKeeper:        app.FTFKeeper,
-		IBCKeeper:        app.IBCKeeper,
+		cdc:                app.appCodec,
+		BankKeeper:         app.BankKeeper,
+		ForwardingKeeper:   app.ForwardingKeeper,
+		ForwardingThrottle: throttle.New(throttle.NewConfig(appOpts)),
+		FTFKeeper:          app.FTFKeeper,
+		IBCKeeper:          app.IBCKeeper,
+		PermissionsKeeper:  app.PermissionsKeeper,
+		WarpKeeper:         &app.WarpKeeper,
 	})
 	if err != nil {
 		return nil, err
 	}
 	app.SetAnteHandler(anteHandler)
 
-	jesterClient := jester.NewClient(cast.ToString(appOpts.Get(jester.FlagGRPCAddress)))
+	preflight.RegisterQueryServer(app.GRPCQueryRouter(), preflight.NewQueryServer(
+		app.txConfig.TxDecoder(),
+		PreflightChecks(app.appCodec, app.FTFKeeper, app.PermissionsKeeper, &app.WarpKeeper)...,
+	))
+
+	jesterConfig, err := jester.NewConfig(appOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if jesterConfig.Enabled {
+		app.Logger().Info("jester is enabled", "endpoints", jesterConfig.Endpoints, "strategy", jesterConfig.Strategy)
+	} else {
+		app.Logger().Info("jester is disabled, pending transfers won't be queried")
+	}
+
+	jesterClient, err := jester.NewClient(jesterConfig, app.Logger())
+	if err != nil {
+		return nil, err
+	}
+
+	dollarInjector := NewDollarInjector(
+		app.txConfig, jesterClient, app.DollarKeeper,
+		app.InjectionKeeper, app.WormholeKeeper,
+		app.StakingKeeper, jesterConfig,
+	)
 	proposalHandler := NewProposalHandler(
-		app.BaseApp, app.Mempool(), app.PreBlocker, app.txConfig,
-		jesterClient, app.DollarKeeper, app.WormholeKeeper,
+		app.BaseApp, app.Mempool(), app.PreBlocker,
+		app.txConfig, dollarInjector,
 	)
 
 	app.SetPrepareProposal(proposalHandler.PrepareProposal())
+	app.SetProcessProposal(proposalHandler.ProcessProposal())
+	app.SetExtendVoteHandler(dollarInjector.ExtendVote())
+	app.SetVerifyVoteExtensionHandler(dollarInjector.VerifyVoteExtension())
 	app.SetPreBlocker(proposalHandler.PreBlocker())
 
 	if err := app.RegisterUpgradeHandler(); err != nil {
@@ -447,6 +494,10 @@ func (app *App) SimulationManager() *module.SimulationManager {
 func (app *App) RegisterAPIRoutes(apiSvr *serverapi.Server, apiConfig serverconfig.APIConfig) {
 	app.App.RegisterAPIRoutes(apiSvr, apiConfig)
 
+	if err := preflight.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, preflight.NewQueryClient(apiSvr.ClientCtx)); err != nil {
+		panic(err)
+	}
+
 	if err := api.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
 	}
@@ -470,15 +521,19 @@ func (app *App) GetSubspace(moduleName string) paramstypes.Subspace {
 }
 
 func (app *App) RegisterUpgradeHandler() error {
-	app.UpgradeKeeper.SetUpgradeHandler(
-		upgrade.UpgradeName,
-		upgrade.CreateUpgradeHandler(
-			app.ModuleManager,
-			app.Configurator(),
-			app.Logger(),
-			app.IBCKeeper.ClientKeeper,
-		),
-	)
+	if err := upgrade.ValidateUpgrades(upgrade.Upgrades); err != nil {
+		return fmt.Errorf("invalid upgrades: %w", err)
+	}
+
+	keepers := upgrade.Keepers{
+		ClientKeeper: app.IBCKeeper.ClientKeeper,
+	}
+	for _, u := range upgrade.Upgrades {
+		app.UpgradeKeeper.SetUpgradeHandler(
+			u.Name,
+			u.Handler(app.ModuleManager, app.Configurator(), app.Logger(), keepers),
+		)
+	}
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
@@ -488,8 +543,10 @@ func (app *App) RegisterUpgradeHandler() error {
 		return nil
 	}
 
-	if upgradeInfo.Name == upgrade.UpgradeName {
-		app.SetStoreLoader(upgrade.CreateStoreLoader(upgradeInfo.Height))
+	// Store upgrades are only applied on networks targeted by the upgrade, as
+	// the upgrade handler fails on all other networks.
+	if u, found := upgrade.GetUpgrade(upgradeInfo.Name); found && u.Targets(app.ChainID()) {
+		app.SetStoreLoader(upgrade.CreateStoreLoader(upgradeInfo.Height, u.StoreUpgrades))
 	}
 
 	return nil
@@ -44,11 +44,14 @@ import (
 
 	"github.com/noble-assets/noble/v11"
 	"github.com/noble-assets/noble/v11/jester"
+	"github.com/noble-assets/noble/v11/preflight"
+	"github.com/noble-assets/noble/v11/throttle"
 )
 
 func addStartFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	jester.AddFlags(startCmd)
+	throttle.AddFlags(startCmd)
 }
 
 func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager module.BasicManager) {
@@ -73,6 +76,7 @@ func initRootCmd(rootCmd *cobra.Command, txConfig client.TxConfig, basicManager
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		jester.GetJesterCmd(),
 	)
 }
 
@@ -93,6 +97,7 @@ func queryCommand() *cobra.Command {
 		server.QueryBlocksCmd(),
 		authcmd.QueryTxCmd(),
 		server.QueryBlockResultsCmd(),
+		preflight.GetPreflightCmd(),
 	)
 
 	return cmd
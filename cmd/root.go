@@ -29,6 +29,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	"github.com/noble-assets/noble/v11/jester"
+	"github.com/noble-assets/noble/v11/throttle"
 	"github.com/spf13/cobra"
 )
 
@@ -81,6 +82,7 @@ func NewRootCmd() *cobra.Command {
 			cmtCfg.Consensus.TimeoutCommit = 500 * time.Millisecond
 
 			customAppTemplate, appConfig := jester.AppendJesterConfig(srvCfg)
+			customAppTemplate += throttle.ConfigTemplate
 
 			return server.InterceptConfigsPreRunHandler(cmd, customAppTemplate, appConfig, cmtCfg)
 		},
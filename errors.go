@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Codespace is the codespace of all errors returned by the Noble specific
+// ante checks.
+const Codespace = "noble"
+
+var (
+	ErrPermissionedAction       = errorsmod.Register(Codespace, 1, "message is a permissioned action")
+	ErrForbiddenCollateralDenom = errorsmod.Register(Codespace, 2, "forbidden collateral denom")
+	ErrNestedAuthzViolation     = errorsmod.Register(Codespace, 3, "nested authz message is not allowed")
+	ErrCircuitBreakerTripped    = errorsmod.Register(Codespace, 4, "circuit breaker is tripped")
+	ErrBlacklistedRecipient     = errorsmod.Register(Codespace, 5, "recipient is blacklisted")
+	ErrForwardingThrottled      = errorsmod.Register(Codespace, 6, "forwarding account transaction is throttled")
+	ErrInjectedTx               = errorsmod.Register(Codespace, 7, "injected transaction is only processed in the PreBlocker")
+)
+
+// MessageError is an error returned for a specific message of a transaction.
+// The ABCI code and codespace of the underlying error are preserved.
+type MessageError struct {
+	// Index is the index of the message inside the transaction.
+	Index int
+	// TypeUrl is the type URL of the message.
+	TypeUrl string
+	// Err is the underlying error.
+	Err error
+}
+
+func NewMessageError(index int, typeUrl string, err error) *MessageError {
+	return &MessageError{Index: index, TypeUrl: typeUrl, Err: err}
+}
+
+func (e *MessageError) Error() string {
+	return fmt.Sprintf("message %d (%s): %s", e.Index, e.TypeUrl, e.Err)
+}
+
+// Cause is used by errorsmod when resolving the ABCI code of an error.
+func (e *MessageError) Cause() error { return e.Err }
+
+func (e *MessageError) Unwrap() error { return e.Err }
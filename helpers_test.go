@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	ftfkeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	ftftypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	injectionkeeper "github.com/noble-assets/noble/v11/x/injection/keeper"
+	injectiontypes "github.com/noble-assets/noble/v11/x/injection/types"
+	permissionskeeper "github.com/noble-assets/noble/v11/x/permissions/keeper"
+	permissionstypes "github.com/noble-assets/noble/v11/x/permissions/types"
+
+	wormholekeeper "github.com/noble-assets/wormhole/keeper"
+	wormholetypes "github.com/noble-assets/wormhole/types"
+
+	dollarportaltypes "dollar.noble.xyz/v2/types/portal"
+)
+
+// mintingDenom is the Fiat TokenFactory minting denom in tests.
+const mintingDenom = "uusdc"
+
+// testKeepers contains the keepers used by the Noble specific checks in tests.
+type testKeepers struct {
+	cdc               codec.Codec
+	txConfig          client.TxConfig
+	ftfKeeper         *ftfkeeper.Keeper
+	injectionKeeper   *injectionkeeper.Keeper
+	permissionsKeeper *permissionskeeper.Keeper
+	wormholeKeeper    *wormholekeeper.Keeper
+}
+
+// mockBankKeeper is a test utility that only knows the metadata of the Fiat
+// TokenFactory minting denom, as required to set it.
+type mockBankKeeper struct {
+	ftftypes.BankKeeper
+}
+
+func (mockBankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	return banktypes.Metadata{Base: denom}, denom == mintingDenom
+}
+
+// mockHeaderService is a test utility that returns the header info of the
+// SDK context.
+type mockHeaderService struct{}
+
+func (mockHeaderService) GetHeaderInfo(ctx context.Context) header.Info {
+	return sdk.UnwrapSDKContext(ctx).HeaderInfo()
+}
+
+// setupKeepers is a test utility that returns the keepers used by the Noble
+// specific checks backed by in-memory stores, alongside a context for them.
+func setupKeepers(t *testing.T) (testKeepers, sdk.Context) {
+	t.Helper()
+
+	keys := storetypes.NewKVStoreKeys(ftftypes.StoreKey, injectiontypes.ModuleName, permissionstypes.ModuleName, wormholetypes.ModuleName)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil)
+	cfg := moduletestutil.MakeTestEncodingConfig(authzmodule.AppModuleBasic{}, bank.AppModuleBasic{})
+	injectiontypes.RegisterInterfaces(cfg.InterfaceRegistry)
+	cfg.InterfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &dollarportaltypes.MsgDeliverInjection{})
+
+	ftfKeeper := ftfkeeper.NewKeeper(cfg.Codec, ctx.Logger(), runtime.NewKVStoreService(keys[ftftypes.StoreKey]), mockBankKeeper{})
+	ftfKeeper.SetMintingDenom(ctx, ftftypes.MintingDenom{Denom: mintingDenom})
+
+	injectionKeeper := injectionkeeper.NewKeeper(
+		sdk.AccAddress("authority").String(),
+		cfg.Codec,
+		runtime.NewKVStoreService(keys[injectiontypes.ModuleName]),
+		runtime.EventService{},
+	)
+	require.NoError(t, injectionKeeper.Params.Set(ctx, injectiontypes.DefaultParams()))
+
+	permissionsKeeper := permissionskeeper.NewKeeper(
+		sdk.AccAddress("authority").String(),
+		cfg.Codec,
+		runtime.NewKVStoreService(keys[permissionstypes.ModuleName]),
+		runtime.EventService{},
+	)
+
+	wormholeKeeper := wormholekeeper.NewKeeper(
+		cfg.Codec,
+		runtime.NewKVStoreService(keys[wormholetypes.ModuleName]),
+		mockHeaderService{},
+		runtime.EventService{},
+		addresscodec.NewBech32Codec("noble"),
+		nil, nil, nil,
+	)
+
+	return testKeepers{
+		cdc:               cfg.Codec,
+		txConfig:          cfg.TxConfig,
+		ftfKeeper:         ftfKeeper,
+		injectionKeeper:   injectionKeeper,
+		permissionsKeeper: permissionsKeeper,
+		wormholeKeeper:    wormholeKeeper,
+	}, ctx
+}
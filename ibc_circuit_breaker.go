@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"encoding/json"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	forwardingtypes "github.com/noble-assets/forwarding/v2/types"
+)
+
+var _ porttypes.IBCModule = &CircuitBreakerMiddleware{}
+
+// CircuitBreakerMiddleware is a custom IBC middleware that rejects incoming
+// ICS-20 transfers whose memo implicitly executes a halted message type on
+// Noble, e.g. a Packet Forward Middleware forward while MsgTransfer is halted.
+//
+// NOTE: Hyperlane messages, including the ones carrying an Orbiter payload,
+// are only delivered through MsgProcessMessage, so they are already covered
+// by the CircuitBreakerDecorator.
+type CircuitBreakerMiddleware struct {
+	app                  porttypes.IBCModule
+	circuitBreakerKeeper CircuitBreakerKeeper
+}
+
+func NewCircuitBreakerMiddleware(app porttypes.IBCModule, circuitBreakerKeeper CircuitBreakerKeeper) CircuitBreakerMiddleware {
+	return CircuitBreakerMiddleware{
+		app:                  app,
+		circuitBreakerKeeper: circuitBreakerKeeper,
+	}
+}
+
+func (m CircuitBreakerMiddleware) OnChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, channelID string, channelCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, version string) (string, error) {
+	return m.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+func (m CircuitBreakerMiddleware) OnChanOpenTry(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, channelID string, channelCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, counterpartyVersion string) (version string, err error) {
+	return m.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+}
+
+func (m CircuitBreakerMiddleware) OnChanOpenAck(ctx sdk.Context, portID string, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	return m.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+func (m CircuitBreakerMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID string, channelID string) error {
+	return m.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+func (m CircuitBreakerMiddleware) OnChanCloseInit(ctx sdk.Context, portID string, channelID string) error {
+	return m.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+func (m CircuitBreakerMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID string, channelID string) error {
+	return m.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+func (m CircuitBreakerMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return m.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	for _, typeUrl := range GetMemoTypeUrls(data.Memo) {
+		tripped, err := m.circuitBreakerKeeper.IsTripped(ctx, typeUrl)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		if tripped {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(ErrCircuitBreakerTripped, "%s is currently halted", typeUrl))
+		}
+	}
+
+	return m.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+func (m CircuitBreakerMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return m.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+func (m CircuitBreakerMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return m.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// GetMemoTypeUrls returns the type URLs of all messages implicitly executed on
+// Noble when receiving a transfer with a memo, i.e. an IBC transfer for a
+// Packet Forward Middleware forward, the registration and forward of a
+// Forwarding account, and all outgoing routes of an Orbiter payload.
+func GetMemoTypeUrls(memo string) (typeUrls []string) {
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil
+	}
+
+	transfer := sdk.MsgTypeURL(&transfertypes.MsgTransfer{})
+
+	if _, ok := fields["forward"]; ok {
+		typeUrls = append(typeUrls, transfer)
+	}
+
+	if noble, ok := fields["noble"].(map[string]any); ok {
+		if _, ok := noble["forwarding"]; ok {
+			typeUrls = append(typeUrls, sdk.MsgTypeURL(&forwardingtypes.MsgRegisterAccount{}), transfer)
+		}
+	}
+
+	if _, ok := fields["orbiter"]; ok {
+		typeUrls = append(typeUrls,
+			transfer,
+			sdk.MsgTypeURL(&cctptypes.MsgDepositForBurn{}),
+			sdk.MsgTypeURL(&warptypes.MsgRemoteTransfer{}),
+		)
+	}
+
+	slices.Sort(typeUrls)
+	return slices.Compact(typeUrls)
+}
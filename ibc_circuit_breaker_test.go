@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"testing"
+
+	hyperlanetypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	permissionstypes "github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+// mockIBCModule is a test utility that counts all received packets.
+type mockIBCModule struct {
+	porttypes.IBCModule
+
+	received int
+}
+
+func (m *mockIBCModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestCircuitBreakerMiddleware(t *testing.T) {
+	transfer := sdk.MsgTypeURL(&transfertypes.MsgTransfer{})
+	remoteTransfer := sdk.MsgTypeURL(&warptypes.MsgRemoteTransfer{})
+
+	tests := []struct {
+		name    string
+		tripped string
+		memo    string
+		halted  bool
+	}{
+		{
+			name:    "transfer without memo",
+			tripped: transfer,
+		},
+		{
+			name:    "forward with halted transfers",
+			tripped: transfer,
+			memo:    `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`,
+			halted:  true,
+		},
+		{
+			name:    "forwarding account with halted transfers",
+			tripped: transfer,
+			memo:    `{"noble":{"forwarding":{"recipient":"cosmos1recipient","channel":"channel-1"}}}`,
+			halted:  true,
+		},
+		{
+			name:    "orbiter payload with halted hyperlane transfers",
+			tripped: remoteTransfer,
+			memo:    `{"orbiter":{"forwarding":{"recipient":"cosmos1recipient"}}}`,
+			halted:  true,
+		},
+		{
+			name:    "forward with unrelated halted message",
+			tripped: remoteTransfer,
+			memo:    `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := setupKeepers(t)
+			require.NoError(t, k.permissionsKeeper.CircuitBreakers.Set(ctx, tc.tripped, permissionstypes.CircuitBreaker{TypeUrl: tc.tripped}))
+
+			app := &mockIBCModule{}
+			middleware := NewCircuitBreakerMiddleware(app, k.permissionsKeeper)
+
+			data := transfertypes.NewFungibleTokenPacketData("uusdc", "1000000", "cosmos1sender", "cosmos1receiver", tc.memo)
+			packet := channeltypes.Packet{Data: data.GetBytes()}
+
+			ack := middleware.OnRecvPacket(ctx, packet, nil)
+			require.Equal(t, !tc.halted, ack.Success())
+			if tc.halted {
+				require.Zero(t, app.received)
+			} else {
+				require.Equal(t, 1, app.received)
+			}
+		})
+	}
+}
+
+// TestCircuitBreakerHyperlane ensures that all inbound Hyperlane messages,
+// including the ones carrying an Orbiter payload, can be halted, as they are
+// only delivered through MsgProcessMessage.
+func TestCircuitBreakerHyperlane(t *testing.T) {
+	k, ctx := setupKeepers(t)
+
+	typeUrl := sdk.MsgTypeURL(&hyperlanetypes.MsgProcessMessage{})
+	require.NoError(t, k.permissionsKeeper.CircuitBreakers.Set(ctx, typeUrl, permissionstypes.CircuitBreaker{TypeUrl: typeUrl}))
+
+	err := NewCircuitBreakerDecorator(k.permissionsKeeper).CheckMessage(ctx, &hyperlanetypes.MsgProcessMessage{})
+	require.ErrorIs(t, err, ErrCircuitBreakerTripped)
+}
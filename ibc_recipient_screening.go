@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = &RecipientScreeningMiddleware{}
+
+// RecipientScreeningMiddleware is a custom IBC middleware that ensures that no
+// blacklisted party is reachable through a hop included in the memo of an
+// incoming ICS-20 transfer, e.g. a Packet Forward Middleware forward.
+type RecipientScreeningMiddleware struct {
+	app      porttypes.IBCModule
+	screener RecipientScreener
+}
+
+func NewRecipientScreeningMiddleware(app porttypes.IBCModule, screener RecipientScreener) RecipientScreeningMiddleware {
+	return RecipientScreeningMiddleware{
+		app:      app,
+		screener: screener,
+	}
+}
+
+func (m RecipientScreeningMiddleware) OnChanOpenInit(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, channelID string, channelCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, version string) (string, error) {
+	return m.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+func (m RecipientScreeningMiddleware) OnChanOpenTry(ctx sdk.Context, order channeltypes.Order, connectionHops []string, portID string, channelID string, channelCap *capabilitytypes.Capability, counterparty channeltypes.Counterparty, counterpartyVersion string) (version string, err error) {
+	return m.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+}
+
+func (m RecipientScreeningMiddleware) OnChanOpenAck(ctx sdk.Context, portID string, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	return m.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+func (m RecipientScreeningMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID string, channelID string) error {
+	return m.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+func (m RecipientScreeningMiddleware) OnChanCloseInit(ctx sdk.Context, portID string, channelID string) error {
+	return m.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+func (m RecipientScreeningMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID string, channelID string) error {
+	return m.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+func (m RecipientScreeningMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return m.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	denomTrace := transfertypes.ParseDenomTrace(data.Denom)
+	if !m.screener.IsMintingDenom(ctx, denomTrace.BaseDenom) {
+		return m.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	if err := m.screener.ScreenMemo(ctx, data.Memo); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return m.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+func (m RecipientScreeningMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return m.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+func (m RecipientScreeningMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return m.app.OnTimeoutPacket(ctx, packet, relayer)
+}
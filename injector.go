@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Injector is a source of data that the block proposer injects into a block
+// proposal, in the form of an unsigned tx that is applied in the PreBlocker.
+// The data is typically provided by a sidecar service running alongside the
+// validator, such as Jester.
+//
+// The ProposalHandler runs an ordered set of injectors. Each injector injects
+// at most a single tx, and all injected txs are placed at the start of the
+// block in the order of the injectors. Injected txs must be marked with the
+// name of their injector using injectiontypes.MarkInjectedTx, as only marked
+// txs are applied in the PreBlocker, and skipped when delivering the block.
+type Injector interface {
+	// Name returns the name of the injector, used to mark injected txs, and
+	// in logs and telemetry.
+	Name() string
+
+	// Budget returns the maximum size and gas of the tx injected by this
+	// injector. Proposals exceeding either are rejected.
+	Budget(ctx sdk.Context) (maxTxBytes int64, maxGas uint64, err error)
+	// Fetch returns the data to inject into a block proposal.
+	Fetch(ctx sdk.Context, req *abci.RequestPrepareProposal) (Injection, error)
+	// Encode builds the injected tx containing as much of the data as fits
+	// within the given size and gas, i.e. the lower of the injector's budget
+	// and the remaining size and gas of the block. It returns nil if nothing
+	// is injected, alongside the gas that is reserved for the injected tx.
+	Encode(ctx sdk.Context, injection Injection, maxTxBytes int64, maxBlockGas uint64) ([]byte, uint64, error)
+	// Validate ensures that a tx marked as injected by this injector is valid,
+	// and returns the decoded tx.
+	Validate(ctx sdk.Context, bz []byte) (sdk.FeeTx, error)
+	// Apply processes a tx marked as injected by this injector.
+	Apply(ctx sdk.Context, bz []byte)
+}
+
+// Injection is the data an injector injects into a block proposal.
+type Injection struct {
+	// Data are the individual items to inject, e.g. transfers.
+	Data [][]byte
+	// Metadata is optional data that all validators require to validate the
+	// injected tx, which is included in its marker.
+	Metadata []byte
+}
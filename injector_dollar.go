@@ -0,0 +1,780 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	jestertypes "github.com/noble-assets/noble/v11/jester"
+	injectionkeeper "github.com/noble-assets/noble/v11/x/injection/keeper"
+	injectiontypes "github.com/noble-assets/noble/v11/x/injection/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	wormholekeeper "github.com/noble-assets/wormhole/keeper"
+	vaautils "github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	dollarkeeper "dollar.noble.xyz/v2/keeper"
+	dollarportaltypes "dollar.noble.xyz/v2/types/portal"
+)
+
+var _ Injector = &DollarInjector{}
+
+// DollarKeeper defines the subset of the $USDN keeper used to deliver the
+// transfers injected from Jester.
+type DollarKeeper interface {
+	Deliver(ctx context.Context, vaa []byte) error
+}
+
+// DollarInjector injects outstanding $USDN transfers, in the form of Wormhole
+// VAAs reported by our sidecar service Jester, into block proposals. The
+// transfers are delivered via the Dollar module's portal in the PreBlocker,
+// and failed deliveries are recorded in the Injection module.
+//
+// Once vote extensions are enabled via the consensus params, all validators
+// attest to the transfers reported by their Jester via the signing digests
+// of the VAAs in their vote extensions, and only transfers attested by
+// sufficient voting power are injected. The vote extensions are included in
+// the injected tx, so that all validators can verify the attestations, while
+// the VAAs themselves are only included once, in the messages of the tx.
+type DollarInjector struct {
+	txConfig client.TxConfig
+
+	jesterClient    *jestertypes.Client
+	injectionKeeper *injectionkeeper.Keeper
+	wormholeKeeper  archivingWormholeKeeper
+	dollarKeeper    DollarKeeper
+	validatorStore  baseapp.ValidatorStore
+
+	config     jestertypes.Config
+	quarantine *jestertypes.Quarantine
+}
+
+func NewDollarInjector(
+	txConfig client.TxConfig,
+	jesterClient *jestertypes.Client,
+	dollarKeeper *dollarkeeper.Keeper,
+	injectionKeeper *injectionkeeper.Keeper,
+	wormholeKeeper *wormholekeeper.Keeper,
+	validatorStore baseapp.ValidatorStore,
+	config jestertypes.Config,
+) *DollarInjector {
+	return &DollarInjector{
+		txConfig: txConfig,
+
+		jesterClient:    jesterClient,
+		injectionKeeper: injectionKeeper,
+		wormholeKeeper:  archivingWormholeKeeper{wormholeKeeper},
+		dollarKeeper:    dollarKeeper,
+		validatorStore:  validatorStore,
+
+		config:     config,
+		quarantine: jestertypes.NewQuarantine(config),
+	}
+}
+
+// Name implements the Injector interface.
+func (i *DollarInjector) Name() string { return "dollar" }
+
+// Budget returns the maximum size of the injected Jester tx, and the gas
+// charged for the maximum number of transfers per block.
+func (i *DollarInjector) Budget(ctx sdk.Context) (int64, uint64, error) {
+	params, err := i.injectionKeeper.Params.Get(ctx)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to get injection params")
+	}
+
+	return int64(params.MaxInjectedTxBytes), params.GasPerVaa * params.MaxVaasPerBlock, nil
+}
+
+// Fetch returns all outstanding, validly signed, and not yet executed $USDN
+// transfers reported by this node's Jester. Once vote extensions are enabled,
+// only transfers attested in the vote extensions of the previous height are
+// returned, alongside the vote extensions. Transfers that repeatedly failed
+// to be delivered are quarantined for a number of blocks.
+func (i *DollarInjector) Fetch(ctx sdk.Context, req *abci.RequestPrepareProposal) (injection Injection, err error) {
+	var attested map[string]bool
+	if voteExtensionsEnabled(ctx, req.Height) {
+		attested, err = i.getAttestedDigests(ctx, req.LocalLastCommit)
+		if err != nil {
+			return Injection{}, errors.Wrap(err, "failed to get attested transfers from vote extensions")
+		}
+		if len(attested) == 0 {
+			return Injection{}, nil
+		}
+
+		injection.Metadata, err = req.LocalLastCommit.Marshal()
+		if err != nil {
+			return Injection{}, errors.Wrap(err, "failed to marshal vote extensions")
+		}
+	}
+
+	injection.Data, err = i.getInjectableVAAs(ctx, i.queryJester(ctx), attested)
+	return injection, err
+}
+
+// Encode builds the injected Jester tx, containing as many of the given
+// transfers as allowed by the maximum number of transfers per block, and the
+// given size and gas. The size includes the vote extensions attesting the
+// transfers, so an error is returned if not even a single transfer fits
+// alongside them. All remaining transfers are deferred, as they are reported
+// again by Jester until they are executed.
+func (i *DollarInjector) Encode(ctx sdk.Context, injection Injection, maxTxBytes int64, maxGas uint64) (bz []byte, gas uint64, err error) {
+	logger := ctx.Logger()
+	vaas := injection.Data
+	total := len(vaas)
+
+	params, err := i.injectionKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get injection params")
+	}
+
+	if uint64(len(vaas)) > params.MaxVaasPerBlock {
+		vaas = vaas[:params.MaxVaasPerBlock]
+	}
+
+	for len(vaas) > 0 {
+		gas = params.GasPerVaa * uint64(len(vaas))
+
+		if gas <= maxGas {
+			bz, err = i.encodeTx(vaas, gas, injection.Metadata)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			if cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz}) <= maxTxBytes {
+				break
+			}
+		}
+
+		bz = nil
+		vaas = vaas[:len(vaas)-1]
+	}
+
+	if total > 0 && len(vaas) == 0 {
+		return nil, 0, fmt.Errorf("no transfer fits within %d bytes and %d gas alongside %d bytes of vote extensions", maxTxBytes, maxGas, len(injection.Metadata))
+	}
+
+	if deferred := total - len(vaas); deferred > 0 {
+		telemetry.IncrCounter(float32(deferred), "jester", "vaas", "deferred")
+		logger.Info(fmt.Sprintf("deferred %d pending transfers from jester to later blocks", deferred))
+	}
+	if len(vaas) == 0 {
+		return nil, 0, nil
+	}
+
+	telemetry.IncrCounter(float32(len(vaas)), "jester", "vaas", "injected")
+	logger.Info(fmt.Sprintf("injected %d pending transfers from jester", len(vaas)))
+
+	return bz, gas, nil
+}
+
+// Validate ensures that the tx injected from Jester only contains well-formed,
+// unique, and not yet executed $USDN transfers, within the maximum number of
+// transfers per block and charged the gas per transfer, and returns the
+// decoded tx.
+// Once vote extensions are enabled, all transfers must additionally be
+// attested in the vote extensions included in the tx.
+func (i *DollarInjector) Validate(ctx sdk.Context, bytes []byte) (sdk.FeeTx, error) {
+	decoded, err := i.txConfig.TxDecoder()(bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode injected tx")
+	}
+
+	msgs := decoded.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("injected tx contains no messages")
+	}
+
+	tx, ok := decoded.(sdk.FeeTx)
+	if !ok {
+		return nil, fmt.Errorf("injected tx does not implement sdk.FeeTx")
+	}
+
+	params, err := i.injectionKeeper.Params.Get(ctx)
+	if err != nil {
+		return tx, errors.Wrap(err, "failed to get injection params")
+	}
+	if uint64(len(msgs)) > params.MaxVaasPerBlock {
+		return tx, fmt.Errorf("injected tx contains %d messages, exceeding the maximum of %d", len(msgs), params.MaxVaasPerBlock)
+	}
+	if expected := params.GasPerVaa * uint64(len(msgs)); tx.GetGas() != expected {
+		return tx, fmt.Errorf("injected tx has gas limit %d, expected %d", tx.GetGas(), expected)
+	}
+
+	attested, err := i.getInjectedAttestations(ctx, decoded)
+	if err != nil {
+		return tx, err
+	}
+
+	vaas := make([]*vaautils.VAA, len(msgs))
+	seen := make(map[string]bool)
+	for index, raw := range msgs {
+		msg, ok := raw.(*dollarportaltypes.MsgDeliverInjection)
+		if !ok {
+			return tx, fmt.Errorf("message %d is %s, expected %s", index, sdk.MsgTypeURL(raw), sdk.MsgTypeURL(&dollarportaltypes.MsgDeliverInjection{}))
+		}
+
+		vaa, err := vaautils.Unmarshal(msg.Vaa)
+		if err != nil {
+			return tx, errors.Wrapf(err, "failed to unmarshal vaa of message %d", index)
+		}
+
+		digest := vaa.SigningDigest().String()
+		if seen[digest] {
+			return tx, fmt.Errorf("message %d contains duplicate vaa %s", index, vaa.MessageID())
+		}
+		seen[digest] = true
+
+		if attested != nil && !attested[string(vaa.SigningDigest().Bytes())] {
+			return tx, fmt.Errorf("message %d contains insufficiently attested vaa %s", index, vaa.MessageID())
+		}
+
+		vaas[index] = vaa
+	}
+
+	executed, err := i.getExecutedVAAs(ctx, vaas)
+	if err != nil {
+		return tx, err
+	}
+	for index, vaa := range vaas {
+		if executed[index] {
+			return tx, fmt.Errorf("message %d contains already executed vaa %s", index, vaa.MessageID())
+		}
+	}
+
+	return tx, nil
+}
+
+// Apply processes all $USDN transfers injected from Jester. Transfers are
+// delivered independently, so that a single failing transfer doesn't prevent
+// the others from being delivered.
+func (i *DollarInjector) Apply(ctx sdk.Context, bytes []byte) {
+	logger := ctx.Logger()
+
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("recovered panic when handling transfers from jester", "err", r)
+		}
+	}()
+
+	tx, err := i.txConfig.TxDecoder()(bytes)
+	if err != nil {
+		logger.Error("failed to unmarshal injected jester tx", "err", err)
+		return
+	}
+
+	var count int
+	for _, raw := range tx.GetMsgs() {
+		msg, ok := raw.(*dollarportaltypes.MsgDeliverInjection)
+		// Validated injected txs only contain MsgDeliverInjection messages.
+		if !ok {
+			break
+		}
+
+		vaa, err := vaautils.Unmarshal(msg.Vaa)
+		if err != nil {
+			logger.Error("failed to unmarshal transfer from jester", "err", err)
+			i.emitTransferFailed(ctx, nil, err)
+			continue
+		}
+
+		cachedCtx, writeCache := ctx.CacheContext()
+		if err := i.dollarKeeper.Deliver(cachedCtx, msg.Vaa); err != nil {
+			logger.Error("failed to process transfer from jester", "identifier", vaa.MessageID(), "err", err)
+			i.emitTransferFailed(ctx, vaa, err)
+
+			i.recordFailedDelivery(ctx, vaa, err)
+
+			if i.quarantine.RecordFailure(ctx.BlockHeight(), vaa.SigningDigest().String()) {
+				logger.Warn("quarantined repeatedly failing transfer from jester", "identifier", vaa.MessageID(), "blocks", i.config.QuarantineBlocks)
+			}
+		} else {
+			writeCache()
+			i.emitTransferDelivered(ctx, vaa)
+			i.quarantine.RecordSuccess(vaa.SigningDigest().String())
+
+			if err := i.injectionKeeper.RemoveFailedDelivery(ctx, vaa.SigningDigest().String(), injectionkeeper.RemovalReasonDelivered); err != nil {
+				logger.Error("failed to remove failed delivery of transfer from jester", "identifier", vaa.MessageID(), "err", err)
+			}
+			count++
+		}
+	}
+	if count > 0 {
+		logger.Info(fmt.Sprintf("processed %d transfers from jester", count))
+	}
+}
+
+// getInjectableVAAs is a utility that returns all well-formed, unique, not
+// quarantined, validly signed, and not yet executed transfers from Jester, in
+// the order they were received. If a set of attested digests is given, only
+// attested transfers are returned. Transfers are decoded concurrently, and all
+// transfers that aren't processed within the decode timeout, or that exceed
+// the maximum number of transfers per block, are deferred to later blocks.
+func (i *DollarInjector) getInjectableVAAs(ctx sdk.Context, vaas [][]byte, attested map[string]bool) ([][]byte, error) {
+	logger := ctx.Logger()
+
+	params, err := i.injectionKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get injection params")
+	}
+
+	deadline, cancel := context.WithTimeout(ctx, i.config.DecodeTimeout)
+	defer cancel()
+
+	decoded, errs := decodeVAAs(deadline, vaas)
+
+	var deferred int
+	var candidates []*vaautils.VAA
+	var candidateVAAs [][]byte
+	seen := make(map[string]bool)
+
+	for index, vaa := range decoded {
+		if errs[index] != nil {
+			logger.Warn("failed to unmarshal transfer from jester", "err", errs[index])
+			continue
+		}
+		if vaa == nil {
+			deferred++
+			continue
+		}
+
+		digest := vaa.SigningDigest().String()
+		if seen[digest] {
+			logger.Warn("skipped duplicate transfer from jester", "identifier", vaa.MessageID())
+			continue
+		}
+		seen[digest] = true
+
+		if attested != nil && !attested[string(vaa.SigningDigest().Bytes())] {
+			logger.Debug("skipped insufficiently attested transfer from jester", "identifier", vaa.MessageID())
+			continue
+		}
+
+		candidates = append(candidates, vaa)
+		candidateVAAs = append(candidateVAAs, vaas[index])
+	}
+
+	executed, err := i.getExecutedVAAs(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var injectableVAAs [][]byte
+	for index, vaa := range candidates {
+		if executed[index] {
+			telemetry.IncrCounter(1, "jester", "vaas", "skipped")
+			logger.Warn("skipped already executed transfer from jester", "identifier", vaa.MessageID())
+			continue
+		}
+
+		if i.quarantine.IsQuarantined(ctx.BlockHeight(), vaa.SigningDigest().String()) {
+			telemetry.IncrCounter(1, "jester", "vaas", "skipped")
+			logger.Warn("skipped quarantined transfer from jester", "identifier", vaa.MessageID())
+			continue
+		}
+
+		if deadline.Err() != nil || uint64(len(injectableVAAs)) >= params.MaxVaasPerBlock {
+			deferred++
+			continue
+		}
+
+		// The VAA is verified against the current guardian set in a discarded
+		// cache, as successful verification marks the VAA as executed.
+		cachedCtx, _ := ctx.CacheContext()
+		if _, err := i.wormholeKeeper.ParseAndVerifyVAA(cachedCtx, candidateVAAs[index]); err != nil {
+			telemetry.IncrCounter(1, "jester", "vaas", "invalid")
+			logger.Warn("skipped transfer from jester with invalid signatures", "identifier", vaa.MessageID(), "err", err)
+			continue
+		}
+
+		injectableVAAs = append(injectableVAAs, candidateVAAs[index])
+	}
+
+	if deferred > 0 {
+		telemetry.IncrCounter(float32(deferred), "jester", "vaas", "deferred")
+		logger.Info(fmt.Sprintf("deferred %d pending transfers from jester to later blocks", deferred))
+	}
+
+	return injectableVAAs, nil
+}
+
+// getExecutedVAAs is a utility that returns for a list of transfers whether
+// each of them was already executed, by looking up their digests in the
+// Wormhole module's archive of executed VAAs.
+func (i *DollarInjector) getExecutedVAAs(ctx context.Context, vaas []*vaautils.VAA) ([]bool, error) {
+	digests := make([][]byte, len(vaas))
+	for index, vaa := range vaas {
+		digests[index] = vaa.SigningDigest().Bytes()
+	}
+
+	executed, err := i.wormholeKeeper.HasExecuted(ctx, digests)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query execution of vaas")
+	}
+
+	return executed, nil
+}
+
+// encodeTx is a utility that returns an encoded, marked tx delivering the
+// given transfers, alongside the given metadata.
+func (i *DollarInjector) encodeTx(vaas [][]byte, gas uint64, metadata []byte) ([]byte, error) {
+	msgs := make([]sdk.Msg, len(vaas))
+	for index, raw := range vaas {
+		msgs[index] = &dollarportaltypes.MsgDeliverInjection{Vaa: raw}
+	}
+
+	builder := i.txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return nil, errors.Wrap(err, "failed to set messages of injected jester tx")
+	}
+	builder.SetGasLimit(gas)
+	if err := injectiontypes.MarkInjectedTx(builder, i.Name(), metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to mark injected jester tx")
+	}
+
+	bz, err := i.txConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal injected jester tx")
+	}
+
+	return bz, nil
+}
+
+// decodeVAAs is a utility that concurrently decodes a set of transfers. All
+// transfers that aren't decoded before the context is done are left nil.
+func decodeVAAs(ctx context.Context, vaas [][]byte) ([]*vaautils.VAA, []error) {
+	decoded := make([]*vaautils.VAA, len(vaas))
+	errs := make([]error, len(vaas))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.NumCPU(), len(vaas)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				decoded[index], errs[index] = vaautils.Unmarshal(vaas[index])
+			}
+		}()
+	}
+
+dispatch:
+	for index := range vaas {
+		// As select chooses randomly among ready cases, the context is checked
+		// first, so that nothing is dispatched once it is done.
+		if ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case indexes <- index:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	return decoded, errs
+}
+
+// queryJester is a utility that returns all outstanding $USDN transfers
+// reported by the Jester sidecar of this node. Nothing is reported when
+// Jester is disabled on this node.
+func (i *DollarInjector) queryJester(ctx sdk.Context) [][]byte {
+	if !i.config.Enabled {
+		return nil
+	}
+
+	start := time.Now()
+	vaas, err := i.jesterClient.GetVAAs(ctx, ctx.BlockHeight())
+	if errors.IsOf(err, jestertypes.ErrCircuitOpen) {
+		telemetry.IncrCounter(1, "jester", "query", "skipped")
+		ctx.Logger().Debug("skipped querying jester", "err", err)
+		return nil
+	}
+	telemetry.MeasureSince(start, "jester", "query")
+	if err != nil {
+		telemetry.IncrCounter(1, "jester", "query", "errors")
+		ctx.Logger().Error("failed to query jester", "err", err)
+		return nil
+	}
+
+	telemetry.IncrCounter(float32(len(vaas)), "jester", "vaas", "returned")
+
+	return vaas
+}
+
+// getInjectedAttestations is a utility that returns the signing digests of
+// all sufficiently attested transfers in the vote extensions included in an
+// injected tx. It returns nil if vote extensions aren't enabled, in which
+// case the tx must not include any.
+func (i *DollarInjector) getInjectedAttestations(ctx sdk.Context, tx sdk.Tx) (map[string]bool, error) {
+	marker, marked := injectiontypes.GetInjectedTx(tx)
+	if !marked {
+		return nil, fmt.Errorf("injected tx is not marked")
+	}
+
+	if !voteExtensionsEnabled(ctx, ctx.BlockHeight()) {
+		if len(marker.Metadata) > 0 {
+			return nil, fmt.Errorf("injected tx includes vote extensions while they are disabled")
+		}
+
+		return nil, nil
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(marker.Metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal vote extensions of injected tx")
+	}
+
+	return i.getAttestedDigests(ctx, extCommit)
+}
+
+// getAttestedDigests is a utility that returns the signing digests of all
+// $USDN transfers included in the vote extensions of the previous height,
+// whose attesting voting power exceeds the on-chain attestation threshold.
+func (i *DollarInjector) getAttestedDigests(ctx sdk.Context, extCommit abci.ExtendedCommitInfo) (map[string]bool, error) {
+	if err := baseapp.ValidateVoteExtensions(ctx, i.validatorStore, 0, "", extCommit); err != nil {
+		return nil, errors.Wrap(err, "failed to validate vote extensions")
+	}
+
+	params, err := i.injectionKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get injection params")
+	}
+
+	var totalPower int64
+	attestedPower := make(map[string]int64)
+
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var voteExtension jestertypes.VoteExtension
+		if err := voteExtension.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+
+		seen := make(map[string]bool)
+		for _, digest := range voteExtension.Digests {
+			if seen[string(digest)] {
+				continue
+			}
+			seen[string(digest)] = true
+
+			attestedPower[string(digest)] += vote.Validator.Power
+		}
+	}
+
+	threshold := params.AttestationThreshold.MulInt64(totalPower)
+
+	attested := make(map[string]bool)
+	for digest, power := range attestedPower {
+		if math.LegacyNewDec(power).GT(threshold) {
+			attested[digest] = true
+		}
+	}
+
+	return attested, nil
+}
+
+// ExtendVote is the logic called by all validators to extend their precommit
+// vote, once vote extensions are enabled via the consensus params. Noble
+// modifies this by attesting to as many outstanding $USDN transfers reported
+// by the validator's Jester as allowed by the vote extension limits, via the
+// signing digests of their VAAs.
+func (i *DollarInjector) ExtendVote() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		params, err := i.injectionKeeper.Params.Get(ctx)
+		if err != nil {
+			ctx.Logger().Error("failed to get injection params", "err", err)
+			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+		}
+
+		var digests [][]byte
+		seen := make(map[string]bool)
+		for _, raw := range i.queryJester(ctx) {
+			if uint64(len(digests)) >= params.MaxVoteExtensionVaas {
+				break
+			}
+
+			vaa, err := vaautils.Unmarshal(raw)
+			if err != nil {
+				ctx.Logger().Warn("failed to unmarshal transfer from jester", "err", err)
+				continue
+			}
+
+			digest := vaa.SigningDigest().Bytes()
+			if seen[string(digest)] {
+				continue
+			}
+			seen[string(digest)] = true
+
+			digests = append(digests, digest)
+		}
+
+		for {
+			voteExtension := jestertypes.VoteExtension{Digests: digests}
+
+			bz, err := voteExtension.Marshal()
+			if err != nil {
+				ctx.Logger().Error("failed to marshal vote extension", "err", err)
+				return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+			}
+
+			if uint64(len(bz)) <= params.MaxVoteExtensionBytes {
+				return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+			}
+			digests = digests[:len(digests)-1]
+		}
+	}
+}
+
+// VerifyVoteExtension is the logic called by all validators to verify the
+// vote extensions of their peers. Noble modifies this by ensuring that the
+// extension is within the vote extension limits, and only contains
+// well-formed and unique digests of $USDN transfers.
+func (i *DollarInjector) VerifyVoteExtension() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		params, err := i.injectionKeeper.Params.Get(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get injection params")
+		}
+
+		if uint64(len(req.VoteExtension)) > params.MaxVoteExtensionBytes {
+			ctx.Logger().Error("rejected oversized vote extension", "validator", sdk.ConsAddress(req.ValidatorAddress), "bytes", len(req.VoteExtension))
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		var voteExtension jestertypes.VoteExtension
+		if err := voteExtension.Unmarshal(req.VoteExtension); err != nil {
+			ctx.Logger().Error("rejected undecodable vote extension", "validator", sdk.ConsAddress(req.ValidatorAddress), "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		if uint64(len(voteExtension.Digests)) > params.MaxVoteExtensionVaas {
+			ctx.Logger().Error("rejected vote extension with too many vaas", "validator", sdk.ConsAddress(req.ValidatorAddress), "vaas", len(voteExtension.Digests))
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		seen := make(map[string]bool)
+		for index, digest := range voteExtension.Digests {
+			if len(digest) != common.HashLength {
+				ctx.Logger().Error("rejected vote extension with invalid digest", "validator", sdk.ConsAddress(req.ValidatorAddress), "index", index, "bytes", len(digest))
+				return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+			}
+
+			if seen[string(digest)] {
+				ctx.Logger().Error("rejected vote extension with duplicate digest", "validator", sdk.ConsAddress(req.ValidatorAddress), "index", index)
+				return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+			}
+			seen[string(digest)] = true
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// emitTransferDelivered is a utility that records a delivered transfer from
+// Jester in both an event and telemetry.
+func (i *DollarInjector) emitTransferDelivered(ctx sdk.Context, vaa *vaautils.VAA) {
+	telemetry.IncrCounter(1, "jester", "vaas", "delivered")
+
+	amount, decimals := getTransferAmount(vaa)
+	if err := ctx.EventManager().EmitTypedEvent(&jestertypes.TransferDelivered{
+		MessageId:    vaa.MessageID(),
+		Digest:       vaa.SigningDigest().String(),
+		EmitterChain: uint32(vaa.EmitterChain),
+		Amount:       amount,
+		Decimals:     decimals,
+	}); err != nil {
+		ctx.Logger().Error("failed to emit transfer delivered event", "err", err)
+	}
+}
+
+// emitTransferFailed is a utility that records a failed transfer from Jester
+// in both an event and telemetry. The VAA is nil if it couldn't be parsed.
+func (i *DollarInjector) emitTransferFailed(ctx sdk.Context, vaa *vaautils.VAA, reason error) {
+	telemetry.IncrCounter(1, "jester", "vaas", "failed")
+
+	event := &jestertypes.TransferFailed{Error: reason.Error()}
+	if vaa != nil {
+		event.MessageId = vaa.MessageID()
+		event.Digest = vaa.SigningDigest().String()
+		event.EmitterChain = uint32(vaa.EmitterChain)
+		event.Amount, event.Decimals = getTransferAmount(vaa)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		ctx.Logger().Error("failed to emit transfer failed event", "err", err)
+	}
+}
+
+// recordFailedDelivery is a utility that records a failed transfer from
+// Jester in state, so that its sender and recipient can query why it failed.
+func (i *DollarInjector) recordFailedDelivery(ctx sdk.Context, vaa *vaautils.VAA, reason error) {
+	delivery := injectiontypes.FailedDelivery{
+		Digest:       vaa.SigningDigest().String(),
+		MessageId:    vaa.MessageID(),
+		EmitterChain: uint32(vaa.EmitterChain),
+		Error:        reason.Error(),
+	}
+	if transfer, ok := jestertypes.ParseTransfer(vaa.Payload); ok {
+		delivery.Sender = injectiontypes.NormalizeSender(hex.EncodeToString(transfer.Sender))
+		delivery.Recipient = sdk.AccAddress(transfer.Recipient[12:]).String()
+		delivery.Amount = strconv.FormatUint(transfer.Amount, 10)
+	}
+
+	if err := i.injectionKeeper.RecordFailedDelivery(ctx, delivery); err != nil {
+		ctx.Logger().Error("failed to record failed delivery of transfer from jester", "identifier", vaa.MessageID(), "err", err)
+	}
+}
+
+// getTransferAmount is a utility that returns the trimmed amount, and its
+// decimals, of a transfer. The amount is empty if it couldn't be parsed.
+func getTransferAmount(vaa *vaautils.VAA) (string, uint32) {
+	transfer, ok := jestertypes.ParseTransfer(vaa.Payload)
+	if !ok {
+		return "", 0
+	}
+
+	return strconv.FormatUint(transfer.Amount, 10), uint32(transfer.Decimals)
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	vaautils "github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	jestertypes "github.com/noble-assets/noble/v11/jester"
+	"github.com/noble-assets/noble/v11/jester/mock"
+
+	wormholetypes "github.com/noble-assets/wormhole/types"
+)
+
+// mockDollarKeeper is a test utility that delivers transfers by executing
+// their VAAs, as the $USDN keeper does, and records them.
+type mockDollarKeeper struct {
+	wormholeKeeper archivingWormholeKeeper
+	delivered      [][]byte
+}
+
+func (k *mockDollarKeeper) Deliver(ctx context.Context, vaa []byte) error {
+	if _, err := k.wormholeKeeper.ParseAndVerifyVAA(ctx, vaa); err != nil {
+		return err
+	}
+
+	k.delivered = append(k.delivered, vaa)
+	return nil
+}
+
+func TestDollarInjectorWithMockJester(t *testing.T) {
+	keepers, ctx := setupKeepers(t)
+	ctx = ctx.WithBlockHeight(1).WithHeaderInfo(header.Info{Height: 1, Time: time.Unix(1, 0)})
+
+	// ARRANGE: A guardian set of a single guardian, signing two transfers.
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, keepers.wormholeKeeper.GuardianSets.Set(ctx, 0, wormholetypes.GuardianSet{
+		Addresses: [][]byte{crypto.PubkeyToAddress(key.PublicKey).Bytes()},
+	}))
+
+	var vaas [][]byte
+	for sequence := range uint64(2) {
+		vaa := &vaautils.VAA{
+			Version:          vaautils.SupportedVAAVersion,
+			Timestamp:        time.Unix(0, 0),
+			EmitterChain:     vaautils.ChainIDEthereum,
+			Sequence:         sequence,
+			ConsistencyLevel: 1,
+		}
+		vaa.AddSignature(key, 0)
+
+		bz, err := vaa.Marshal()
+		require.NoError(t, err)
+		vaas = append(vaas, bz)
+	}
+
+	// ARRANGE: A mock Jester on a local listener, serving both transfers
+	// alongside an unsigned one.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := mock.NewServer(log.NewNopLogger(), vaas[0], vaas[1], testVAA(t, 2, nil))
+
+	serverCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- server.Serve(serverCtx, listener, false) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	config := jestertypes.Config{
+		Enabled:       true,
+		Endpoints:     []string{listener.Addr().String()},
+		Strategy:      jestertypes.StrategyFailover,
+		DecodeTimeout: 5 * time.Second,
+		Timeout:       5 * time.Second,
+	}
+	client, err := jestertypes.NewClient(config, log.NewNopLogger())
+	require.NoError(t, err)
+
+	wormholeKeeper := archivingWormholeKeeper{keepers.wormholeKeeper}
+	dollarKeeper := &mockDollarKeeper{wormholeKeeper: wormholeKeeper}
+	injector := &DollarInjector{
+		txConfig:        keepers.txConfig,
+		jesterClient:    client,
+		injectionKeeper: keepers.injectionKeeper,
+		wormholeKeeper:  wormholeKeeper,
+		dollarKeeper:    dollarKeeper,
+		config:          config,
+		quarantine:      jestertypes.NewQuarantine(config),
+	}
+
+	var defaults defaultHandlers
+	handler := mockProposalHandler(keepers.txConfig, &defaults, injector)
+
+	// ACT: Prepare a proposal.
+	prepared, err := handler.PrepareProposal()(ctx, &abci.RequestPrepareProposal{Height: 1, MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+
+	// ASSERT: The signed transfers are injected at the start of the block.
+	require.Len(t, prepared.Txs, 2)
+	require.Equal(t, []byte("mempool"), prepared.Txs[1])
+
+	tx, err := keepers.txConfig.TxDecoder()(prepared.Txs[0])
+	require.NoError(t, err)
+	require.Len(t, tx.GetMsgs(), 2)
+
+	// ACT: Process the proposal.
+	processed, err := handler.ProcessProposal()(ctx, &abci.RequestProcessProposal{Height: 1, Txs: prepared.Txs})
+
+	// ASSERT: The proposal is accepted.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processed.Status)
+	require.Equal(t, [][]byte{[]byte("mempool")}, defaults.txs)
+
+	// ACT: Finalize the block.
+	_, err = handler.PreBlocker()(ctx, &abci.RequestFinalizeBlock{Height: 1, Txs: prepared.Txs})
+	require.NoError(t, err)
+
+	// ASSERT: The signed transfers are delivered in order.
+	require.Equal(t, vaas, dollarKeeper.delivered)
+
+	// ACT: Prepare a proposal for the next block.
+	ctx = ctx.WithBlockHeight(2).WithHeaderInfo(header.Info{Height: 2, Time: time.Unix(2, 0)})
+	prepared, err = handler.PrepareProposal()(ctx, &abci.RequestPrepareProposal{Height: 2, MaxTxBytes: 1 << 20})
+
+	// ASSERT: The delivered transfers are no longer injected.
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("mempool")}, prepared.Txs)
+}
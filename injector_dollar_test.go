@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	vaautils "github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	jestertypes "github.com/noble-assets/noble/v11/jester"
+	injectiontypes "github.com/noble-assets/noble/v11/x/injection/types"
+
+	dollarportaltypes "dollar.noble.xyz/v2/types/portal"
+)
+
+func TestValidate(t *testing.T) {
+	keepers, ctx := setupKeepers(t)
+	injector := &DollarInjector{
+		txConfig:        keepers.txConfig,
+		injectionKeeper: keepers.injectionKeeper,
+		wormholeKeeper:  archivingWormholeKeeper{keepers.wormholeKeeper},
+	}
+
+	params, err := keepers.injectionKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxVaasPerBlock = 2
+	require.NoError(t, keepers.injectionKeeper.Params.Set(ctx, params))
+
+	vaa1, vaa2, vaa3 := testVAA(t, 1, nil), testVAA(t, 2, nil), testVAA(t, 3, nil)
+
+	// ARRANGE: An injected tx charged the gas per transfer.
+	bz := encodeInjectedTx(t, keepers.txConfig, 2*params.GasPerVaa, nil, vaa1, vaa2)
+	// ACT: Attempt to validate.
+	_, err = injector.Validate(ctx, bz)
+	// ASSERT: The tx is valid.
+	require.NoError(t, err)
+
+	// ARRANGE: An injected tx not charged the gas per transfer.
+	bz = encodeInjectedTx(t, keepers.txConfig, 2*params.GasPerVaa-1, nil, vaa1, vaa2)
+	// ACT: Attempt to validate.
+	_, err = injector.Validate(ctx, bz)
+	// ASSERT: The tx is invalid.
+	require.ErrorContains(t, err, "gas limit")
+
+	// ARRANGE: An injected tx with more than the maximum number of transfers.
+	bz = encodeInjectedTx(t, keepers.txConfig, 3*params.GasPerVaa, nil, vaa1, vaa2, vaa3)
+	// ACT: Attempt to validate.
+	_, err = injector.Validate(ctx, bz)
+	// ASSERT: The tx is invalid.
+	require.ErrorContains(t, err, "exceeding the maximum")
+
+	// ARRANGE: An injected tx with a duplicate transfer.
+	bz = encodeInjectedTx(t, keepers.txConfig, 2*params.GasPerVaa, nil, vaa1, vaa1)
+	// ACT: Attempt to validate.
+	_, err = injector.Validate(ctx, bz)
+	// ASSERT: The tx is invalid.
+	require.ErrorContains(t, err, "duplicate")
+
+	// ARRANGE: An injected tx including vote extensions while they are disabled.
+	bz = encodeInjectedTx(t, keepers.txConfig, params.GasPerVaa, []byte("metadata"), vaa1)
+	// ACT: Attempt to validate.
+	_, err = injector.Validate(ctx, bz)
+	// ASSERT: The tx is invalid.
+	require.ErrorContains(t, err, "vote extensions")
+
+	// ARRANGE: An injected tx with an already executed transfer.
+	decoded, err := vaautils.Unmarshal(vaa2)
+	require.NoError(t, err)
+	require.NoError(t, keepers.wormholeKeeper.VAAArchive.Set(ctx, decoded.SigningDigest().Bytes(), collections.Join(decoded.MessageID(), true)))
+	bz = encodeInjectedTx(t, keepers.txConfig, 2*params.GasPerVaa, nil, vaa1, vaa2)
+	// ACT: Attempt to validate.
+	_, err = injector.Validate(ctx, bz)
+	// ASSERT: The tx is invalid.
+	require.ErrorContains(t, err, "already executed")
+}
+
+func TestEncode(t *testing.T) {
+	keepers, ctx := setupKeepers(t)
+	injector := &DollarInjector{txConfig: keepers.txConfig, injectionKeeper: keepers.injectionKeeper}
+
+	params, err := keepers.injectionKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	vaa1, vaa2, vaa3 := testVAA(t, 1, nil), testVAA(t, 2, nil), testVAA(t, 3, nil)
+	injection := Injection{Data: [][]byte{vaa1, vaa2, vaa3}, Metadata: bytes.Repeat([]byte{1}, 10_000)}
+	maxGas := 3 * params.GasPerVaa
+
+	size := func(bz []byte) int64 {
+		return cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})
+	}
+	allTx := encodeInjectedTx(t, keepers.txConfig, 3*params.GasPerVaa, injection.Metadata, vaa1, vaa2, vaa3)
+	twoTx := encodeInjectedTx(t, keepers.txConfig, 2*params.GasPerVaa, injection.Metadata, vaa1, vaa2)
+	oneTx := encodeInjectedTx(t, keepers.txConfig, params.GasPerVaa, injection.Metadata, vaa1)
+
+	// ARRANGE: A budget fitting all transfers alongside the vote extensions.
+	// ACT: Attempt to encode.
+	bz, gas, err := injector.Encode(ctx, injection, size(allTx), maxGas)
+	// ASSERT: All transfers are injected.
+	require.NoError(t, err)
+	require.Equal(t, allTx, bz)
+	require.Equal(t, 3*params.GasPerVaa, gas)
+
+	// ARRANGE: A budget a single byte short of fitting all transfers.
+	// ACT: Attempt to encode.
+	bz, gas, err = injector.Encode(ctx, injection, size(allTx)-1, maxGas)
+	// ASSERT: The last transfer is deferred.
+	require.NoError(t, err)
+	require.Equal(t, twoTx, bz)
+	require.Equal(t, 2*params.GasPerVaa, gas)
+
+	// ARRANGE: A budget fitting only a single transfer.
+	// ACT: Attempt to encode.
+	bz, _, err = injector.Encode(ctx, injection, size(oneTx), maxGas)
+	// ASSERT: Only the first transfer is injected.
+	require.NoError(t, err)
+	require.Equal(t, oneTx, bz)
+
+	// ARRANGE: A budget fitting the vote extensions, but no transfer.
+	// ACT: Attempt to encode.
+	_, _, err = injector.Encode(ctx, injection, size(oneTx)-1, maxGas)
+	// ASSERT: The encoding fails, as nothing can be injected.
+	require.ErrorContains(t, err, "bytes of vote extensions")
+
+	// ARRANGE: A budget smaller than the vote extensions alone.
+	// ACT: Attempt to encode.
+	_, _, err = injector.Encode(ctx, injection, int64(len(injection.Metadata)), maxGas)
+	// ASSERT: The encoding fails, as nothing can be injected.
+	require.ErrorContains(t, err, "bytes of vote extensions")
+
+	// ARRANGE: Nothing to inject.
+	// ACT: Attempt to encode.
+	bz, _, err = injector.Encode(ctx, Injection{Metadata: injection.Metadata}, size(allTx), maxGas)
+	// ASSERT: Nothing is injected, without an error.
+	require.NoError(t, err)
+	require.Nil(t, bz)
+}
+
+func TestVerifyVoteExtension(t *testing.T) {
+	keepers, ctx := setupKeepers(t)
+	injector := &DollarInjector{injectionKeeper: keepers.injectionKeeper}
+	handler := injector.VerifyVoteExtension()
+
+	params, err := keepers.injectionKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxVoteExtensionVaas = 2
+	params.MaxVoteExtensionBytes = 102
+	require.NoError(t, keepers.injectionKeeper.Params.Set(ctx, params))
+
+	verify := func(digests ...[]byte) abci.ResponseVerifyVoteExtension_VerifyStatus {
+		voteExtension := jestertypes.VoteExtension{Digests: digests}
+		bz, err := voteExtension.Marshal()
+		require.NoError(t, err)
+
+		res, err := handler(ctx, &abci.RequestVerifyVoteExtension{VoteExtension: bz})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	digest := func(sequence uint64) []byte {
+		vaa, err := vaautils.Unmarshal(testVAA(t, sequence, nil))
+		require.NoError(t, err)
+		return vaa.SigningDigest().Bytes()
+	}
+
+	// ARRANGE: An empty vote extension.
+	// ACT: Attempt to verify.
+	res, err := handler(ctx, &abci.RequestVerifyVoteExtension{})
+	// ASSERT: The extension is accepted.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, res.Status)
+
+	// ARRANGE: A vote extension within the limits.
+	// ACT: Attempt to verify.
+	// ASSERT: The extension is accepted.
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verify(digest(1), digest(2)))
+
+	// ARRANGE: A vote extension with more than the maximum number of digests.
+	// ACT: Attempt to verify.
+	// ASSERT: The extension is rejected.
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify(digest(1), digest(2), digest(3)))
+
+	// ARRANGE: A vote extension larger than the maximum size.
+	// ACT: Attempt to verify.
+	// ASSERT: The extension is rejected.
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify(make([]byte, 101)))
+
+	// ARRANGE: A vote extension with a duplicate digest.
+	// ACT: Attempt to verify.
+	// ASSERT: The extension is rejected.
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify(digest(1), digest(1)))
+
+	// ARRANGE: A vote extension with an invalid digest.
+	// ACT: Attempt to verify.
+	// ASSERT: The extension is rejected.
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verify([]byte("invalid")))
+}
+
+func TestDecodeVAAs(t *testing.T) {
+	vaas := [][]byte{testVAA(t, 1, nil), []byte("invalid"), testVAA(t, 2, nil)}
+
+	// ACT: Decode before the deadline.
+	decoded, errs := decodeVAAs(context.Background(), vaas)
+
+	// ASSERT: All transfers are decoded, or failed to decode.
+	require.Equal(t, uint64(1), decoded[0].Sequence)
+	require.NoError(t, errs[0])
+	require.Nil(t, decoded[1])
+	require.Error(t, errs[1])
+	require.Equal(t, uint64(2), decoded[2].Sequence)
+	require.NoError(t, errs[2])
+
+	// ARRANGE: A deadline that has already passed.
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	for range 100 {
+		// ACT: Decode after the deadline.
+		decoded, errs = decodeVAAs(ctx, vaas)
+
+		// ASSERT: No transfers are decoded, so that all are deferred.
+		require.Equal(t, []*vaautils.VAA{nil, nil, nil}, decoded)
+		require.Equal(t, []error{nil, nil, nil}, errs)
+	}
+}
+
+// testVAA is a test utility that returns an unsigned, encoded VAA.
+func testVAA(t *testing.T, sequence uint64, payload []byte) []byte {
+	t.Helper()
+
+	bz, err := (&vaautils.VAA{
+		Version:          vaautils.SupportedVAAVersion,
+		Timestamp:        time.Unix(0, 0),
+		EmitterChain:     vaautils.ChainIDEthereum,
+		Sequence:         sequence,
+		ConsistencyLevel: 1,
+		Payload:          payload,
+	}).Marshal()
+	require.NoError(t, err)
+
+	return bz
+}
+
+// encodeInjectedTx is a test utility that returns an encoded, marked tx
+// delivering the given transfers.
+func encodeInjectedTx(t *testing.T, txConfig client.TxConfig, gas uint64, metadata []byte, vaas ...[]byte) []byte {
+	t.Helper()
+
+	msgs := make([]sdk.Msg, len(vaas))
+	for index, raw := range vaas {
+		msgs[index] = &dollarportaltypes.MsgDeliverInjection{Vaa: raw}
+	}
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(gas)
+	require.NoError(t, injectiontypes.MarkInjectedTx(builder, "dollar", metadata))
+
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	return bz
+}
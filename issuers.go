@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"context"
+
+	dollarkeeper "dollar.noble.xyz/v2/keeper"
+	dollartypes "dollar.noble.xyz/v2/types"
+	ftfkeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	ftftypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	florinkeeper "github.com/monerium/module-noble/v2/keeper"
+	florintypes "github.com/monerium/module-noble/v2/types"
+	halokeeper "github.com/noble-assets/halo/v2/keeper"
+	halotypes "github.com/noble-assets/halo/v2/types"
+	aurakeeper "github.com/ondoprotocol/usdy-noble/v2/keeper"
+	auratypes "github.com/ondoprotocol/usdy-noble/v2/types"
+
+	permissionstypes "github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+// IssuerKeepers returns the adapters exposing the denoms issued on Noble, and
+// their current owners, to the Noble Permissions module, keyed by module name.
+func IssuerKeepers(
+	ftfKeeper *ftfkeeper.Keeper,
+	auraKeeper *aurakeeper.Keeper,
+	haloKeeper *halokeeper.Keeper,
+	florinKeeper *florinkeeper.Keeper,
+	dollarKeeper *dollarkeeper.Keeper,
+) map[string]permissionstypes.IssuerKeeper {
+	return map[string]permissionstypes.IssuerKeeper{
+		ftftypes.ModuleName:    ftfIssuer{ftfKeeper},
+		auratypes.ModuleName:   auraIssuer{auraKeeper},
+		halotypes.ModuleName:   haloIssuer{haloKeeper},
+		florintypes.ModuleName: florinIssuer{florinKeeper},
+		dollartypes.ModuleName: dollarIssuer{dollarKeeper},
+	}
+}
+
+// ftfIssuer exposes the USDC minting denom of the Circle Fiat TokenFactory.
+type ftfIssuer struct{ keeper *ftfkeeper.Keeper }
+
+func (i ftfIssuer) GetIssuedDenoms(ctx context.Context) []string {
+	if !i.keeper.MintingDenomSet(ctx) {
+		return nil
+	}
+
+	return []string{i.keeper.GetMintingDenom(ctx).Denom}
+}
+
+func (i ftfIssuer) GetIssuer(ctx context.Context, _ string) string {
+	owner, _ := i.keeper.GetOwner(ctx)
+	return owner.Address
+}
+
+// auraIssuer exposes the USDY denom of the Ondo Aura module.
+type auraIssuer struct{ keeper *aurakeeper.Keeper }
+
+func (i auraIssuer) GetIssuedDenoms(_ context.Context) []string {
+	return []string{i.keeper.Denom}
+}
+
+func (i auraIssuer) GetIssuer(ctx context.Context, _ string) string {
+	return i.keeper.GetOwner(ctx)
+}
+
+// haloIssuer exposes the USYC denom of the Hashnote Halo module.
+type haloIssuer struct{ keeper *halokeeper.Keeper }
+
+func (i haloIssuer) GetIssuedDenoms(_ context.Context) []string {
+	return []string{i.keeper.Denom}
+}
+
+func (i haloIssuer) GetIssuer(ctx context.Context, _ string) string {
+	return i.keeper.GetOwner(ctx)
+}
+
+// florinIssuer exposes the denoms of the Monerium Florin module, e.g. EURe.
+type florinIssuer struct{ keeper *florinkeeper.Keeper }
+
+func (i florinIssuer) GetIssuedDenoms(ctx context.Context) []string {
+	return i.keeper.GetAllowedDenoms(ctx)
+}
+
+func (i florinIssuer) GetIssuer(ctx context.Context, denom string) string {
+	return i.keeper.GetOwner(ctx, denom)
+}
+
+// dollarIssuer exposes the denom of the Noble Dollar. The Noble Dollar has no
+// dedicated owner, so it is managed by the authority.
+type dollarIssuer struct{ keeper *dollarkeeper.Keeper }
+
+func (i dollarIssuer) GetIssuedDenoms(_ context.Context) []string {
+	return []string{i.keeper.GetDenom()}
+}
+
+func (i dollarIssuer) GetIssuer(_ context.Context, _ string) string {
+	return ""
+}
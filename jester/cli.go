@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jester
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/noble-assets/noble/v11/jester/mock"
+)
+
+const (
+	FlagMockAddress = "address"
+	FlagMockFixture = "fixture"
+	FlagMockNoAdmin = "no-admin"
+)
+
+// GetJesterCmd returns the parent command for all Jester related commands.
+func GetJesterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "jester",
+		Short:                      "Jester related subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(GetServeMockCmd())
+
+	return cmd
+}
+
+// GetServeMockCmd returns a command that runs a stand-in for Jester, serving
+// VAAs from a fixture file and the admin endpoint.
+func GetServeMockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve-mock",
+		Short: "Run a stand-in for Jester for local networks and tests",
+		Long: fmt.Sprintf(`Run a stand-in for Jester, that reports a configurable set of VAAs as
+outstanding $USDN transfers. The VAAs are initially read from the fixture file,
+and can be managed via the admin endpoint at %s, unless disabled.
+
+Fixtures and admin requests contain hex encoded VAAs, either as a JSON object
+{"vaas": ["..."]}, as a JSON array of strings, or as one VAA per line.`, mock.AdminPath),
+		Example: fmt.Sprintf(`serve-mock --fixture vaas.json
+curl -X POST --data '{"vaas": ["01000000..."]}' http://%s%s`, defaultJesterAddress, mock.AdminPath),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			address, _ := cmd.Flags().GetString(FlagMockAddress)
+			fixture, _ := cmd.Flags().GetString(FlagMockFixture)
+			noAdmin, _ := cmd.Flags().GetBool(FlagMockNoAdmin)
+
+			var vaas [][]byte
+			if fixture != "" {
+				var err error
+				vaas, err = mock.LoadFixture(fixture)
+				if err != nil {
+					return err
+				}
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			server := mock.NewServer(log.NewLogger(cmd.OutOrStdout()), vaas...)
+			return server.ListenAndServe(ctx, address, !noAdmin)
+		},
+	}
+
+	cmd.Flags().String(FlagMockAddress, defaultJesterAddress, `Address to listen on, either a host and port or a unix domain socket prefixed with "unix://"`)
+	cmd.Flags().String(FlagMockFixture, "", "File containing the VAAs initially served")
+	cmd.Flags().Bool(FlagMockNoAdmin, false, "Disable the admin endpoint used to manage the served VAAs")
+
+	return cmd
+}
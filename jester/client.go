@@ -17,19 +17,353 @@
 package jester
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"connectrpc.com/connect"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	jester "jester.noble.xyz/api"
 )
 
-func NewClient(address string) jester.QueryServiceClient {
+var (
+	// ErrCircuitOpen is returned when Jester isn't queried, as all endpoints
+	// failed too many consecutive times.
+	ErrCircuitOpen = errors.New("jester circuit breaker is open")
+	// ErrNoQuorum is returned when fewer endpoints than the configured quorum
+	// successfully responded.
+	ErrNoQuorum = errors.New("jester endpoints did not reach quorum")
+)
+
+// unixPrefix is the address prefix of a Jester listening on a unix domain socket.
+const unixPrefix = "unix://"
+
+// Client is a resilient client for one or more Jester endpoints. Every query
+// is subject to a timeout and retries, and endpoints that failed too many
+// consecutive times aren't queried for a number of blocks. Responses of the
+// endpoints are combined according to the configured strategy.
+type Client struct {
+	endpoints []*endpoint
+	config    Config
+	logger    log.Logger
+}
+
+// endpoint is a single Jester endpoint, alongside its health.
+type endpoint struct {
+	index   string
+	address string
+	client  jester.QueryServiceClient
+
+	mu           sync.Mutex
+	failures     uint64
+	trippedUntil int64
+}
+
+// NewClient returns a resilient client for the Jester endpoints configured in
+// config. An address can either be a host and port, an HTTP(S) URL, or the
+// path of a unix domain socket prefixed with "unix://".
+func NewClient(config Config, logger log.Logger) (*Client, error) {
+	tlsConfig, err := config.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{config: config, logger: logger.With("module", "jester")}
+	for index, address := range config.Endpoints {
+		jesterClient := newQueryServiceClient(address, tlsConfig)
+		client.endpoints = append(client.endpoints, &endpoint{
+			index:   strconv.Itoa(index),
+			address: address,
+			client:  jesterClient,
+		})
+	}
+
+	return client, nil
+}
+
+// newQueryServiceClient is an internal helper that returns a Jester client
+// for a single address.
+func newQueryServiceClient(address string, tlsConfig *tls.Config) jester.QueryServiceClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if path, ok := strings.CutPrefix(address, unixPrefix); ok {
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", path)
+		}
+		// The host is ignored when dialing a unix domain socket.
+		address = "http://jester"
+	}
+
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
 	if !strings.Contains(address, "://") {
-		address = "http://" + address
+		if tlsConfig != nil {
+			address = "https://" + address
+		} else {
+			address = "http://" + address
+		}
+	}
+
+	return jester.NewQueryServiceClient(&http.Client{Transport: transport}, address)
+}
+
+// GetVAAs returns all outstanding $USDN transfers reported by Jester. The
+// height is used to track for how long the circuit breaker of an endpoint
+// remains open.
+func (c *Client) GetVAAs(ctx context.Context, height int64) ([][]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
+	defer cancel()
+
+	switch c.config.Strategy {
+	case StrategyMerge:
+		return c.getMergedVAAs(ctx, height)
+	case StrategyQuorum:
+		return c.getQuorumVAAs(ctx, height)
+	default:
+		return c.getFailoverVAAs(ctx, height)
+	}
+}
+
+// getFailoverVAAs is an internal helper that returns the response of the
+// first healthy endpoint that successfully responds.
+func (c *Client) getFailoverVAAs(ctx context.Context, height int64) ([][]byte, error) {
+	var errs []error
+	for _, e := range c.endpoints {
+		if e.isOpen(height) {
+			continue
+		}
+
+		vaas, err := c.query(ctx, e, height)
+		if err == nil {
+			return vaas, nil
+		}
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return nil, ErrCircuitOpen
+	}
+	return nil, errors.Join(errs...)
+}
+
+// getMergedVAAs is an internal helper that queries all healthy endpoints in
+// parallel, and returns the de-duplicated union of their responses.
+func (c *Client) getMergedVAAs(ctx context.Context, height int64) ([][]byte, error) {
+	responses, errs, queried := c.queryAll(ctx, height)
+	if queried == 0 {
+		return nil, ErrCircuitOpen
+	}
+	if len(responses) == 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return combineVAAs(responses, 1), nil
+}
+
+// getQuorumVAAs is an internal helper that queries all healthy endpoints in
+// parallel, and returns the transfers reported by at least a quorum of them.
+func (c *Client) getQuorumVAAs(ctx context.Context, height int64) ([][]byte, error) {
+	responses, errs, queried := c.queryAll(ctx, height)
+	if queried == 0 {
+		return nil, ErrCircuitOpen
+	}
+
+	quorum := c.config.QuorumSize()
+	if uint64(len(responses)) < quorum {
+		return nil, fmt.Errorf("%w: %d of %d responded: %w", ErrNoQuorum, len(responses), quorum, errors.Join(errs...))
+	}
+
+	return combineVAAs(responses, quorum), nil
+}
+
+// queryAll is an internal helper that queries all healthy endpoints in
+// parallel. It returns the successful responses in order of the endpoints,
+// the errors, and the number of endpoints that were queried.
+func (c *Client) queryAll(ctx context.Context, height int64) ([][][]byte, []error, int) {
+	results := make([][][]byte, len(c.endpoints))
+	errs := make([]error, len(c.endpoints))
+	queried := make([]bool, len(c.endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range c.endpoints {
+		if e.isOpen(height) {
+			continue
+		}
+
+		queried[i] = true
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = c.query(ctx, e, height)
+		}()
+	}
+	wg.Wait()
+
+	var responses [][][]byte
+	var failures []error
+	count := 0
+	for i := range c.endpoints {
+		if !queried[i] {
+			continue
+		}
+		count++
+
+		if errs[i] != nil {
+			failures = append(failures, errs[i])
+		} else {
+			responses = append(responses, results[i])
+		}
+	}
+
+	return responses, failures, count
+}
+
+// combineVAAs is an internal helper that returns all transfers reported in at
+// least threshold responses, de-duplicated and in the order they were first
+// reported.
+func combineVAAs(responses [][][]byte, threshold uint64) [][]byte {
+	counts := make(map[string]uint64)
+	var order []string
+	for _, vaas := range responses {
+		seen := make(map[string]bool)
+		for _, vaa := range vaas {
+			key := string(vaa)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			if counts[key] == 0 {
+				order = append(order, key)
+			}
+			counts[key]++
+		}
+	}
+
+	var vaas [][]byte
+	for _, key := range order {
+		if counts[key] >= threshold {
+			vaas = append(vaas, []byte(key))
+		}
+	}
+
+	return vaas
+}
+
+// query is an internal helper that queries a single endpoint, retrying with
+// an exponential backoff as long as the timeout allows, and records the
+// resulting health of the endpoint.
+func (c *Client) query(ctx context.Context, e *endpoint, height int64) ([][]byte, error) {
+	var err error
+	for attempt := uint64(0); attempt <= c.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				err = errors.Join(err, ctx.Err())
+				c.recordFailure(e, height, err)
+				return nil, err
+			case <-time.After(c.config.RetryBackoff << (attempt - 1)):
+			}
+		}
+
+		var res *connect.Response[jester.GetVoteExtensionResponse]
+		res, err = e.client.GetVoteExtension(ctx, connect.NewRequest(&jester.GetVoteExtensionRequest{}))
+		if err == nil {
+			c.recordSuccess(e)
+			return res.Msg.GetDollar().GetVaas(), nil
+		}
+	}
+
+	c.recordFailure(e, height, err)
+	return nil, err
+}
+
+// isOpen is an internal helper that returns if the circuit breaker of an
+// endpoint is open at a given height.
+func (e *endpoint) isOpen(height int64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return height < e.trippedUntil
+}
+
+// recordSuccess is an internal helper that resets the consecutive failures
+// of an endpoint.
+func (c *Client) recordSuccess(e *endpoint) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.failures > 0 || e.trippedUntil > 0 {
+		c.logger.Info("jester endpoint recovered", "endpoint", e.address)
+	}
+	e.failures = 0
+	e.trippedUntil = 0
+
+	telemetry.SetGauge(1, "jester", "endpoint", e.index, "healthy")
+}
+
+// recordFailure is an internal helper that records a failed query of an
+// endpoint, and opens its circuit breaker once the threshold of consecutive
+// failures is reached.
+func (c *Client) recordFailure(e *endpoint, height int64, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.failures++
+	c.logger.Error("failed to query jester endpoint", "endpoint", e.address, "failures", e.failures, "err", err)
+
+	telemetry.IncrCounter(1, "jester", "endpoint", e.index, "errors")
+	telemetry.SetGauge(0, "jester", "endpoint", e.index, "healthy")
+
+	if c.config.CircuitBreakerThreshold > 0 && e.failures >= c.config.CircuitBreakerThreshold {
+		e.failures = 0
+		e.trippedUntil = height + c.config.CircuitBreakerBlocks
+		c.logger.Error("opened jester endpoint circuit breaker", "endpoint", e.address, "until", e.trippedUntil)
+	}
+}
+
+// TLSConfig returns the TLS configuration used to connect to Jester, or nil if
+// neither a custom CA nor a client certificate is configured.
+func (config Config) TLSConfig() (*tls.Config, error) {
+	if config.TLSCAFile == "" && config.TLSCertFile == "" && config.TLSKeyFile == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if config.TLSCAFile != "" {
+		bz, err := os.ReadFile(config.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read jester ca file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bz) {
+			return nil, fmt.Errorf("failed to parse jester ca file %s", config.TLSCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.TLSCertFile != "" || config.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.TLSCertFile, config.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load jester client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
 	}
 
-	return jester.NewQueryServiceClient(
-		http.DefaultClient,
-		address,
-	)
+	return tlsConfig, nil
 }
@@ -17,18 +17,51 @@
 package jester
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 )
 
 const (
-	defaultJesterAddress = "localhost:9091"
+	defaultEnabled             = true
+	defaultJesterAddress       = "localhost:9091"
+	defaultQuarantineThreshold = 3
+	defaultQuarantineBlocks    = 100
+	defaultDecodeTimeout       = 250 * time.Millisecond
+
+	defaultStrategy = StrategyFailover
+
+	defaultTimeout                 = 500 * time.Millisecond
+	defaultMaxRetries              = 2
+	defaultRetryBackoff            = 50 * time.Millisecond
+	defaultCircuitBreakerThreshold = 3
+	defaultCircuitBreakerBlocks    = 10
 )
 
 // AppendJesterConfig appends the Jester configuration to app.toml
 func AppendJesterConfig(srvCfg *serverconfig.Config) (customAppTemplate string, NobleAppConfig interface{}) {
 	type JesterConfig struct {
-		GRPCAddress string `mapstructure:"grpc-address"`
+		Enabled             bool   `mapstructure:"enabled"`
+		GRPCAddress         string `mapstructure:"grpc-address"`
+		QuarantineThreshold uint64 `mapstructure:"quarantine-threshold"`
+		QuarantineBlocks    int64  `mapstructure:"quarantine-blocks"`
+		DecodeTimeout       string `mapstructure:"decode-timeout"`
+		Strategy            string `mapstructure:"strategy"`
+		Quorum              uint64 `mapstructure:"quorum"`
+
+		Timeout                 string `mapstructure:"timeout"`
+		MaxRetries              uint64 `mapstructure:"max-retries"`
+		RetryBackoff            string `mapstructure:"retry-backoff"`
+		CircuitBreakerThreshold uint64 `mapstructure:"circuit-breaker-threshold"`
+		CircuitBreakerBlocks    int64  `mapstructure:"circuit-breaker-blocks"`
+		TLSCAFile               string `mapstructure:"tls-ca-file"`
+		TLSCertFile             string `mapstructure:"tls-cert-file"`
+		TLSKeyFile              string `mapstructure:"tls-key-file"`
 	}
 
 	type CustomAppConfig struct {
@@ -38,7 +71,18 @@ func AppendJesterConfig(srvCfg *serverconfig.Config) (customAppTemplate string,
 	}
 
 	defaultJesterConfig := JesterConfig{
-		GRPCAddress: defaultJesterAddress,
+		Enabled:             defaultEnabled,
+		GRPCAddress:         defaultJesterAddress,
+		QuarantineThreshold: defaultQuarantineThreshold,
+		QuarantineBlocks:    defaultQuarantineBlocks,
+		DecodeTimeout:       defaultDecodeTimeout.String(),
+		Strategy:            string(defaultStrategy),
+
+		Timeout:                 defaultTimeout.String(),
+		MaxRetries:              defaultMaxRetries,
+		RetryBackoff:            defaultRetryBackoff.String(),
+		CircuitBreakerThreshold: defaultCircuitBreakerThreshold,
+		CircuitBreakerBlocks:    defaultCircuitBreakerBlocks,
 	}
 
 	NobleAppConfig = CustomAppConfig{Config: *srvCfg, JesterConfig: defaultJesterConfig}
@@ -50,9 +94,57 @@ func AppendJesterConfig(srvCfg *serverconfig.Config) (customAppTemplate string,
 
 [jester]
 
+# Whether this node queries Jester for pending transfers, when proposing blocks
+# or extending votes. Only validators need to run Jester, so this can be
+# disabled on full nodes and sentries. An empty grpc-address also disables it.
+enabled = {{ .JesterConfig.Enabled }}
+
 # Jester's gRPC server address. 
 # This should not conflict with the CometBFT gRPC server.
+# Use a "unix://" prefix to connect over a unix domain socket, or a "https://"
+# prefix to connect over TLS. Multiple endpoints can be comma separated.
 grpc-address = "{{ .JesterConfig.GRPCAddress }}"
+
+# Strategy used when multiple endpoints are configured:
+# - failover: use the first healthy endpoint that responds
+# - merge: query all endpoints and merge their responses
+# - quorum: only use transfers reported by at least a quorum of endpoints
+strategy = "{{ .JesterConfig.Strategy }}"
+
+# Number of endpoints that must report a transfer when using the quorum
+# strategy. A value of 0 requires a majority of the endpoints.
+quorum = {{ .JesterConfig.Quorum }}
+
+# Number of failed deliveries after which a pending transfer is quarantined,
+# and not injected for quarantine-blocks blocks. Set to 0 to disable.
+quarantine-threshold = {{ .JesterConfig.QuarantineThreshold }}
+quarantine-blocks = {{ .JesterConfig.QuarantineBlocks }}
+
+# Time budget for decoding and verifying pending transfers when building a
+# proposal. Transfers exceeding this budget are deferred to later blocks.
+decode-timeout = "{{ .JesterConfig.DecodeTimeout }}"
+
+# Total time budget for querying Jester when building a proposal or vote
+# extension, including all retries.
+timeout = "{{ .JesterConfig.Timeout }}"
+
+# Number of times a failed query is retried within the time budget.
+max-retries = {{ .JesterConfig.MaxRetries }}
+
+# Backoff before the first retry, doubled on every subsequent retry.
+retry-backoff = "{{ .JesterConfig.RetryBackoff }}"
+
+# Number of consecutive failed queries after which Jester is no longer queried
+# for circuit-breaker-blocks blocks. Set to 0 to disable the circuit breaker.
+circuit-breaker-threshold = {{ .JesterConfig.CircuitBreakerThreshold }}
+circuit-breaker-blocks = {{ .JesterConfig.CircuitBreakerBlocks }}
+
+# Custom CA used to verify Jester's TLS certificate.
+tls-ca-file = "{{ .JesterConfig.TLSCAFile }}"
+
+# Client certificate and key presented to Jester for mutual TLS.
+tls-cert-file = "{{ .JesterConfig.TLSCertFile }}"
+tls-key-file = "{{ .JesterConfig.TLSKeyFile }}"
 `
 	return customAppTemplate, NobleAppConfig
 }
@@ -60,9 +152,178 @@ grpc-address = "{{ .JesterConfig.GRPCAddress }}"
 // Flags
 
 const (
-	FlagGRPCAddress = "jester.grpc-address"
+	FlagEnabled             = "jester.enabled"
+	FlagGRPCAddress         = "jester.grpc-address"
+	FlagQuarantineThreshold = "jester.quarantine-threshold"
+	FlagQuarantineBlocks    = "jester.quarantine-blocks"
+	FlagDecodeTimeout       = "jester.decode-timeout"
+	FlagStrategy            = "jester.strategy"
+	FlagQuorum              = "jester.quorum"
+
+	FlagTimeout                 = "jester.timeout"
+	FlagMaxRetries              = "jester.max-retries"
+	FlagRetryBackoff            = "jester.retry-backoff"
+	FlagCircuitBreakerThreshold = "jester.circuit-breaker-threshold"
+	FlagCircuitBreakerBlocks    = "jester.circuit-breaker-blocks"
+	FlagTLSCAFile               = "jester.tls-ca-file"
+	FlagTLSCertFile             = "jester.tls-cert-file"
+	FlagTLSKeyFile              = "jester.tls-key-file"
 )
 
 func AddFlags(cmd *cobra.Command) {
-	cmd.Flags().String(FlagGRPCAddress, defaultJesterAddress, "Jester's gRPC server address")
+	cmd.Flags().Bool(FlagEnabled, defaultEnabled, "Whether this node queries Jester for pending transfers")
+	cmd.Flags().String(FlagGRPCAddress, defaultJesterAddress, "Jester's gRPC server address, or comma separated list of addresses")
+	cmd.Flags().Uint64(FlagQuarantineThreshold, defaultQuarantineThreshold, "Number of failed deliveries after which a pending transfer is quarantined")
+	cmd.Flags().Int64(FlagQuarantineBlocks, defaultQuarantineBlocks, "Number of blocks a quarantined transfer isn't injected")
+	cmd.Flags().Duration(FlagDecodeTimeout, defaultDecodeTimeout, "Time budget for decoding and verifying pending transfers when building a proposal")
+	cmd.Flags().String(FlagStrategy, string(defaultStrategy), "Strategy used to query multiple Jester endpoints (failover|merge|quorum)")
+	cmd.Flags().Uint64(FlagQuorum, 0, "Number of Jester endpoints that must report a transfer when using the quorum strategy")
+
+	cmd.Flags().Duration(FlagTimeout, defaultTimeout, "Total time budget for querying Jester, including retries")
+	cmd.Flags().Uint64(FlagMaxRetries, defaultMaxRetries, "Number of times a failed Jester query is retried")
+	cmd.Flags().Duration(FlagRetryBackoff, defaultRetryBackoff, "Backoff before the first retry of a failed Jester query")
+	cmd.Flags().Uint64(FlagCircuitBreakerThreshold, defaultCircuitBreakerThreshold, "Consecutive failed queries after which Jester is temporarily no longer queried")
+	cmd.Flags().Int64(FlagCircuitBreakerBlocks, defaultCircuitBreakerBlocks, "Number of blocks Jester is no longer queried after failing")
+	cmd.Flags().String(FlagTLSCAFile, "", "Custom CA used to verify Jester's TLS certificate")
+	cmd.Flags().String(FlagTLSCertFile, "", "Client certificate presented to Jester for mutual TLS")
+	cmd.Flags().String(FlagTLSKeyFile, "", "Client key presented to Jester for mutual TLS")
+}
+
+// Strategy defines how the responses of multiple Jester endpoints are combined.
+type Strategy string
+
+const (
+	// StrategyFailover uses the first healthy endpoint that responds.
+	StrategyFailover Strategy = "failover"
+	// StrategyMerge queries all endpoints and merges their responses.
+	StrategyMerge Strategy = "merge"
+	// StrategyQuorum only uses transfers reported by a quorum of endpoints.
+	StrategyQuorum Strategy = "quorum"
+)
+
+// Config defines the node specific configuration of how pending transfers
+// from Jester are injected into block proposals.
+type Config struct {
+	// Enabled is whether this node queries Jester. It is always disabled when
+	// no endpoints are configured.
+	Enabled bool
+	// Endpoints are the addresses of Jester.
+	Endpoints []string
+	// Strategy is how the responses of multiple endpoints are combined.
+	Strategy Strategy
+	// Quorum is the number of endpoints that must report a transfer when using
+	// the quorum strategy. Zero requires a majority of the endpoints.
+	Quorum uint64
+	// QuarantineThreshold is the number of failed deliveries after which a
+	// transfer isn't injected for QuarantineBlocks blocks. Zero disables the
+	// quarantine.
+	QuarantineThreshold uint64
+	QuarantineBlocks    int64
+	// DecodeTimeout is the time budget for decoding and verifying transfers
+	// when building a proposal.
+	DecodeTimeout time.Duration
+
+	// Timeout is the total time budget of a query to Jester, including retries.
+	Timeout time.Duration
+	// MaxRetries is the number of times a failed query is retried.
+	MaxRetries uint64
+	// RetryBackoff is the backoff before the first retry, which is doubled on
+	// every subsequent retry.
+	RetryBackoff time.Duration
+	// CircuitBreakerThreshold is the number of consecutive failed queries after
+	// which Jester isn't queried for CircuitBreakerBlocks blocks. Zero disables
+	// the circuit breaker.
+	CircuitBreakerThreshold uint64
+	CircuitBreakerBlocks    int64
+
+	// TLSCAFile is the custom CA used to verify Jester's certificate.
+	TLSCAFile string
+	// TLSCertFile and TLSKeyFile are the client certificate and key presented
+	// to Jester for mutual TLS.
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+// NewConfig reads the Jester configuration from the application options.
+func NewConfig(appOpts servertypes.AppOptions) (Config, error) {
+	var endpoints []string
+	for _, address := range strings.Split(cast.ToString(appOpts.Get(FlagGRPCAddress)), ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			endpoints = append(endpoints, address)
+		}
+	}
+
+	enabled := defaultEnabled
+	if value := appOpts.Get(FlagEnabled); value != nil {
+		enabled = cast.ToBool(value)
+	}
+
+	strategy := Strategy(cast.ToString(appOpts.Get(FlagStrategy)))
+	switch strategy {
+	case "":
+		strategy = defaultStrategy
+	case StrategyFailover, StrategyMerge, StrategyQuorum:
+	default:
+		return Config{}, fmt.Errorf("invalid %s: unknown strategy %s", FlagStrategy, strategy)
+	}
+
+	quorum := cast.ToUint64(appOpts.Get(FlagQuorum))
+	if strategy == StrategyQuorum && quorum > uint64(len(endpoints)) {
+		return Config{}, fmt.Errorf("invalid %s: exceeds the %d configured endpoints", FlagQuorum, len(endpoints))
+	}
+
+	timeout := defaultTimeout
+	if value := appOpts.Get(FlagTimeout); value != nil {
+		timeout = cast.ToDuration(value)
+	}
+	if timeout <= 0 {
+		return Config{}, fmt.Errorf("invalid %s: must be positive", FlagTimeout)
+	}
+	quarantineBlocks := cast.ToInt64(appOpts.Get(FlagQuarantineBlocks))
+	if quarantineBlocks < 0 {
+		return Config{}, fmt.Errorf("invalid %s: must not be negative", FlagQuarantineBlocks)
+	}
+
+	decodeTimeout := defaultDecodeTimeout
+	if value := appOpts.Get(FlagDecodeTimeout); value != nil {
+		decodeTimeout = cast.ToDuration(value)
+	}
+	if decodeTimeout <= 0 {
+		return Config{}, fmt.Errorf("invalid %s: must be positive", FlagDecodeTimeout)
+	}
+
+	circuitBreakerBlocks := cast.ToInt64(appOpts.Get(FlagCircuitBreakerBlocks))
+	if circuitBreakerBlocks < 0 {
+		return Config{}, fmt.Errorf("invalid %s: must not be negative", FlagCircuitBreakerBlocks)
+	}
+
+	return Config{
+		Enabled:             enabled && len(endpoints) > 0,
+		Endpoints:           endpoints,
+		Strategy:            strategy,
+		Quorum:              quorum,
+		QuarantineThreshold: cast.ToUint64(appOpts.Get(FlagQuarantineThreshold)),
+		QuarantineBlocks:    quarantineBlocks,
+		DecodeTimeout:       decodeTimeout,
+
+		Timeout:                 timeout,
+		MaxRetries:              cast.ToUint64(appOpts.Get(FlagMaxRetries)),
+		RetryBackoff:            cast.ToDuration(appOpts.Get(FlagRetryBackoff)),
+		CircuitBreakerThreshold: cast.ToUint64(appOpts.Get(FlagCircuitBreakerThreshold)),
+		CircuitBreakerBlocks:    circuitBreakerBlocks,
+
+		TLSCAFile:   cast.ToString(appOpts.Get(FlagTLSCAFile)),
+		TLSCertFile: cast.ToString(appOpts.Get(FlagTLSCertFile)),
+		TLSKeyFile:  cast.ToString(appOpts.Get(FlagTLSKeyFile)),
+	}, nil
+}
+
+// QuorumSize returns the number of endpoints that must report a transfer when
+// using the quorum strategy.
+func (config Config) QuorumSize() uint64 {
+	if config.Quorum > 0 {
+		return config.Quorum
+	}
+
+	return uint64(len(config.Endpoints))/2 + 1
 }
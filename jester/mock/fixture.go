@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// maxAdminBodySize is the maximum size of a request to the admin endpoint.
+const maxAdminBodySize = 16 << 20
+
+// Fixture is the JSON representation of a set of hex encoded VAAs.
+type Fixture struct {
+	VAAs []string `json:"vaas"`
+}
+
+// LoadFixture reads a set of VAAs from a fixture file, see ParseVAAs.
+func LoadFixture(path string) ([][]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	vaas, err := ParseVAAs(bz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+
+	return vaas, nil
+}
+
+// ReadVAAs reads a set of VAAs from reader, see ParseVAAs.
+func ReadVAAs(reader io.Reader) ([][]byte, error) {
+	bz, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseVAAs(bz)
+}
+
+// ParseVAAs parses a set of hex encoded VAAs, optionally prefixed with "0x".
+// The VAAs are either provided as a JSON object in the format of Fixture, as a
+// JSON array of strings, or as plain text with one VAA per line. Empty lines
+// and lines starting with "#" are ignored in plain text.
+func ParseVAAs(bz []byte) ([][]byte, error) {
+	bz = bytes.TrimSpace(bz)
+
+	var encoded []string
+	switch {
+	case len(bz) == 0:
+	case bz[0] == '{':
+		var fixture Fixture
+		if err := json.Unmarshal(bz, &fixture); err != nil {
+			return nil, err
+		}
+		encoded = fixture.VAAs
+	case bz[0] == '[':
+		if err := json.Unmarshal(bz, &encoded); err != nil {
+			return nil, err
+		}
+	default:
+		scanner := bufio.NewScanner(bytes.NewReader(bz))
+		scanner.Buffer(nil, maxAdminBodySize)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			encoded = append(encoded, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	vaas := make([][]byte, 0, len(encoded))
+	for index, value := range encoded {
+		vaa, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(value), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid vaa %d: %w", index, err)
+		}
+		if len(vaa) == 0 {
+			return nil, fmt.Errorf("invalid vaa %d: empty", index)
+		}
+		vaas = append(vaas, vaa)
+	}
+
+	return vaas, nil
+}
+
+// EncodeVAAs hex encodes a set of VAAs, as used in fixtures.
+func EncodeVAAs(vaas [][]byte) []string {
+	encoded := make([]string, 0, len(vaas))
+	for _, vaa := range vaas {
+		encoded = append(encoded, hex.EncodeToString(vaa))
+	}
+
+	return encoded
+}
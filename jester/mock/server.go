@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mock implements a stand-in for Jester, serving a configurable set of
+// $USDN transfers. It is intended for local networks and tests, so that the
+// injection of transfers can be exercised without a Jester sidecar.
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"cosmossdk.io/log"
+	jester "jester.noble.xyz/api"
+)
+
+// AdminPath is the path of the admin endpoint used to manage the served VAAs.
+//
+//   - GET returns the served VAAs.
+//   - POST appends VAAs to the served VAAs.
+//   - PUT replaces the served VAAs.
+//   - DELETE removes all served VAAs.
+//
+// VAAs are exchanged in the same format as fixture files, see ParseVAAs.
+const AdminPath = "/admin/vaas"
+
+// unixPrefix is the address prefix of a unix domain socket.
+const unixPrefix = "unix://"
+
+var _ jester.QueryServiceHandler = &Server{}
+
+// Server is a stand-in for Jester, that reports a configurable set of VAAs as
+// outstanding $USDN transfers.
+type Server struct {
+	jester.UnimplementedQueryServiceHandler
+
+	logger log.Logger
+
+	mu   sync.RWMutex
+	vaas [][]byte
+}
+
+// NewServer returns a new Server, that initially serves the provided VAAs.
+func NewServer(logger log.Logger, vaas ...[]byte) *Server {
+	server := &Server{logger: logger.With("module", "jester-mock")}
+	server.SetVAAs(vaas...)
+
+	return server
+}
+
+// VAAs returns the currently served VAAs.
+func (s *Server) VAAs() [][]byte {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return copyVAAs(s.vaas)
+}
+
+// AddVAAs appends VAAs to the currently served VAAs.
+func (s *Server) AddVAAs(vaas ...[]byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vaas = append(s.vaas, copyVAAs(vaas)...)
+}
+
+// SetVAAs replaces the currently served VAAs.
+func (s *Server) SetVAAs(vaas ...[]byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.vaas = copyVAAs(vaas)
+}
+
+// ClearVAAs removes all currently served VAAs.
+func (s *Server) ClearVAAs() {
+	s.SetVAAs()
+}
+
+// GetVoteExtension implements jester.QueryServiceHandler.
+func (s *Server) GetVoteExtension(_ context.Context, _ *connect.Request[jester.GetVoteExtensionRequest]) (*connect.Response[jester.GetVoteExtensionResponse], error) {
+	return connect.NewResponse(&jester.GetVoteExtensionResponse{
+		Dollar: &jester.Dollar{Vaas: s.VAAs()},
+	}), nil
+}
+
+// Handler returns an HTTP handler serving both the Jester query service and,
+// if enabled, the admin endpoint.
+func (s *Server) Handler(admin bool) http.Handler {
+	mux := http.NewServeMux()
+
+	path, handler := jester.NewQueryServiceHandler(s)
+	mux.Handle(path, handler)
+
+	if admin {
+		mux.HandleFunc(AdminPath, s.handleAdmin)
+	}
+
+	return mux
+}
+
+// ListenAndServe serves the Jester query service and, if enabled, the admin
+// endpoint on address until ctx is done. The address can either be a host and
+// port, or the path of a unix domain socket prefixed with "unix://".
+func (s *Server) ListenAndServe(ctx context.Context, address string, admin bool) error {
+	network := "tcp"
+	if path, ok := strings.CutPrefix(address, unixPrefix); ok {
+		network, address = "unix", path
+	}
+
+	var config net.ListenConfig
+	listener, err := config.Listen(ctx, network, address)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(ctx, listener, admin)
+}
+
+// Serve serves the Jester query service and, if enabled, the admin endpoint
+// on listener until ctx is done.
+func (s *Server) Serve(ctx context.Context, listener net.Listener, admin bool) error {
+	// Unencrypted HTTP/2 is enabled, so that gRPC clients are supported.
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+
+	server := &http.Server{
+		Handler:           s.Handler(admin),
+		Protocols:         protocols,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	s.logger.Info("serving mock jester", "address", listener.Addr().String(), "admin", admin)
+
+	err := server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// handleAdmin is an internal handler for the admin endpoint.
+func (s *Server) handleAdmin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPost, http.MethodPut:
+		vaas, err := ReadVAAs(http.MaxBytesReader(w, r.Body, maxAdminBodySize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if r.Method == http.MethodPost {
+			s.AddVAAs(vaas...)
+		} else {
+			s.SetVAAs(vaas...)
+		}
+		s.logger.Info("updated served vaas", "method", r.Method, "count", len(vaas))
+	case http.MethodDelete:
+		s.ClearVAAs()
+		s.logger.Info("cleared served vaas")
+	default:
+		w.Header().Set("Allow", "GET, POST, PUT, DELETE")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(Fixture{VAAs: EncodeVAAs(s.VAAs())})
+}
+
+// copyVAAs is an internal helper that deep copies a set of VAAs, so that the
+// served VAAs can't be modified by callers.
+func copyVAAs(vaas [][]byte) [][]byte {
+	res := make([][]byte, 0, len(vaas))
+	for _, vaa := range vaas {
+		res = append(res, append([]byte(nil), vaa...))
+	}
+
+	return res
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jester
+
+import (
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Quarantine keeps track of transfers that repeatedly failed to be delivered,
+// so that they aren't injected for a number of blocks. As it only applies to
+// building block proposals, all state is kept in memory and is not part of
+// consensus.
+type Quarantine struct {
+	threshold uint64
+	blocks    int64
+
+	mu      sync.Mutex
+	records map[string]*quarantineRecord
+}
+
+// quarantineRecord is the failure history of a single transfer.
+type quarantineRecord struct {
+	failures     uint64
+	lastFailure  int64
+	releasedFrom int64
+}
+
+// NewQuarantine returns a quarantine for the given configuration. Transfers
+// are only quarantined if the configured threshold is non-zero.
+func NewQuarantine(config Config) *Quarantine {
+	return &Quarantine{
+		threshold: config.QuarantineThreshold,
+		blocks:    config.QuarantineBlocks,
+		records:   make(map[string]*quarantineRecord),
+	}
+}
+
+// IsQuarantined returns if the transfer with the given digest is quarantined
+// at the given height.
+func (q *Quarantine) IsQuarantined(height int64, digest string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	record, found := q.records[digest]
+	return found && height < record.releasedFrom
+}
+
+// RecordFailure records a failed delivery of the transfer with the given
+// digest, and quarantines it once the threshold of failures is reached. It
+// returns if the transfer was quarantined.
+func (q *Quarantine) RecordFailure(height int64, digest string) bool {
+	if q.threshold == 0 {
+		return false
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.prune(height)
+
+	record, found := q.records[digest]
+	if !found {
+		record = &quarantineRecord{}
+		q.records[digest] = record
+	}
+
+	record.failures++
+	record.lastFailure = height
+
+	quarantined := false
+	if record.failures >= q.threshold {
+		record.failures = 0
+		record.releasedFrom = height + q.blocks + 1
+		quarantined = true
+
+		telemetry.IncrCounter(1, "jester", "vaas", "quarantined")
+	}
+
+	telemetry.SetGauge(float32(len(q.records)), "jester", "quarantine", "records")
+	return quarantined
+}
+
+// RecordSuccess removes the transfer with the given digest from the
+// quarantine, once it is delivered.
+func (q *Quarantine) RecordSuccess(digest string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.records, digest)
+}
+
+// prune is an internal helper that removes all records that are neither
+// quarantined, nor failed within the last quarantine period.
+func (q *Quarantine) prune(height int64) {
+	for digest, record := range q.records {
+		if height >= record.releasedFrom && record.lastFailure < height-q.blocks {
+			delete(q.records, digest)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jester
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+var (
+	// transceiverMessagePrefix is the prefix of a Wormhole NTT transceiver message.
+	transceiverMessagePrefix = []byte{0x99, 0x45, 0xFF, 0x10}
+	// nativeTokenTransferPrefix is the prefix of a Wormhole NTT token transfer.
+	nativeTokenTransferPrefix = []byte{0x99, 0x4E, 0x54, 0x54}
+)
+
+// Transfer is a Wormhole NTT token transfer.
+type Transfer struct {
+	// Amount is the trimmed amount of the transfer.
+	Amount uint64
+	// Decimals are the decimals of the trimmed amount.
+	Decimals uint8
+	// Sender is the universal address of the sender on the source chain.
+	Sender []byte
+	// Recipient is the universal address of the recipient on Noble.
+	Recipient []byte
+}
+
+// ParseTransfer returns the Wormhole NTT token transfer included in the
+// payload of a VAA.
+func ParseTransfer(payload []byte) (transfer Transfer, ok bool) {
+	// The transceiver message consists of the prefix, the source and
+	// recipient managers, and the length prefixed manager message.
+	if len(payload) < 70 || !bytes.Equal(payload[:4], transceiverMessagePrefix) {
+		return Transfer{}, false
+	}
+	managerMessage := payload[70:]
+	if len(managerMessage) < int(binary.BigEndian.Uint16(payload[68:70])) {
+		return Transfer{}, false
+	}
+
+	// The manager message consists of the ID, the sender, and the length
+	// prefixed token transfer.
+	if len(managerMessage) < 66 {
+		return Transfer{}, false
+	}
+	tokenTransfer := managerMessage[66:]
+
+	// The token transfer consists of the prefix, the decimals, and the amount,
+	// followed by the source token, the recipient, and the recipient chain.
+	if len(tokenTransfer) < 77 || !bytes.Equal(tokenTransfer[:4], nativeTokenTransferPrefix) {
+		return Transfer{}, false
+	}
+
+	return Transfer{
+		Amount:    binary.BigEndian.Uint64(tokenTransfer[5:13]),
+		Decimals:  tokenTransfer[4],
+		Sender:    managerMessage[32:64],
+		Recipient: tokenTransfer[45:77],
+	}, true
+}
@@ -97,7 +97,9 @@ func (app *App) RegisterLegacyModules() error {
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		scopedICAHostKeeper,
-		app.MsgServiceRouter(),
+		// Wrap the message router so that messages executed by interchain
+		// accounts are subject to the same checks as native transactions.
+		NewPermissionedMsgRouter(app.MsgServiceRouter(), app.appCodec, app.FTFKeeper, app.PermissionsKeeper, &app.WarpKeeper),
 		authoritytypes.ModuleAddress.String(),
 	)
 	app.ICAHostKeeper.WithQueryRouter(app.GRPCQueryRouter())
@@ -153,6 +155,8 @@ func (app *App) RegisterLegacyModules() error {
 		0,
 		pfmkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 	)
+	transferStack = NewRecipientScreeningMiddleware(transferStack, NewRecipientScreener(app.FTFKeeper))
+	transferStack = NewCircuitBreakerMiddleware(transferStack, app.PermissionsKeeper)
 	transferStack = blockibc.NewIBCMiddleware(transferStack, app.FTFKeeper)
 	transferStack = dollar.NewIBCModule(transferStack, app.DollarKeeper)
 
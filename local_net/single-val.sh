@@ -12,6 +12,10 @@ for arg in "$@"; do
     rm -rf $HOME1
     shift
     ;;
+  -j | --jester)
+    JESTER=true
+    shift
+    ;;
   esac
 done
 
@@ -30,4 +34,13 @@ if ! [ -f $HOME1/data/priv_validator_state.json ]; then
   $BIN genesis collect-gentxs --home $HOME1 &>/dev/null
 fi
 
+if [ "$JESTER" = true ]; then
+  if [ -n "$JESTER_FIXTURE" ]; then
+    $BIN jester serve-mock --fixture "$JESTER_FIXTURE" &
+  else
+    $BIN jester serve-mock &
+  fi
+  trap "kill $!" EXIT
+fi
+
 $BIN start --home $HOME1
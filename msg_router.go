@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory"
+	ftfkeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+
+	permissionskeeper "github.com/noble-assets/noble/v11/x/permissions/keeper"
+)
+
+var _ icatypes.MessageRouter = &PermissionedMsgRouter{}
+
+// PermissionedMsgRouter is a custom message router that applies the Noble
+// specific ante checks to every message before routing it. This ensures that
+// messages executed outside a transaction, e.g. by the ICA host, can't bypass
+// the checks applied to natively submitted messages.
+type PermissionedMsgRouter struct {
+	router icatypes.MessageRouter
+
+	circuitBreaker CircuitBreakerDecorator
+	isPaused       fiattokenfactory.IsPausedDecorator
+	isBlacklisted  fiattokenfactory.IsBlacklistedDecorator
+	permissioned   PermissionedMessagesDecorator
+	screening      RecipientScreeningDecorator
+}
+
+func NewPermissionedMsgRouter(
+	router icatypes.MessageRouter,
+	cdc codec.Codec,
+	ftfKeeper *ftfkeeper.Keeper,
+	permissionsKeeper *permissionskeeper.Keeper,
+	warpKeeper *warpkeeper.Keeper,
+) PermissionedMsgRouter {
+	return PermissionedMsgRouter{
+		router: router,
+
+		circuitBreaker: NewCircuitBreakerDecorator(permissionsKeeper),
+		isPaused:       fiattokenfactory.NewIsPausedDecorator(cdc, ftfKeeper),
+		isBlacklisted:  fiattokenfactory.NewIsBlacklistedDecorator(ftfKeeper),
+		permissioned:   NewPermissionedMessagesDecorator(permissionsKeeper),
+		screening:      NewRecipientScreeningDecorator(NewRecipientScreener(ftfKeeper), warpKeeper),
+	}
+}
+
+func (r PermissionedMsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		msgs := []sdk.Msg{msg}
+
+		if err := r.circuitBreaker.CheckMessage(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := r.isPaused.CheckMessages(ctx, msgs); err != nil {
+			return nil, err
+		}
+		if err := r.isBlacklisted.CheckMessages(ctx, msgs, nil); err != nil {
+			return nil, err
+		}
+		if err := r.permissioned.CheckMessage(ctx, msg); err != nil {
+			return nil, err
+		}
+		if err := r.screening.CheckMessage(ctx, msg); err != nil {
+			return nil, err
+		}
+
+		return handler(r.isBlacklisted.AddGranteeToContextIfPresent(ctx, msgs), msg)
+	}
+}
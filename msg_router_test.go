@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"testing"
+
+	ftftypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	forwardingtypes "github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+
+	permissionstypes "github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+// mockMsgRouter is a test utility that records all routed messages, and that
+// doesn't have a handler for bank multi sends.
+type mockMsgRouter struct {
+	routed []sdk.Msg
+}
+
+func (r *mockMsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	if _, ok := msg.(*banktypes.MsgMultiSend); ok {
+		return nil
+	}
+
+	return func(_ sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		r.routed = append(r.routed, msg)
+		return &sdk.Result{}, nil
+	}
+}
+
+func TestPermissionedMsgRouter(t *testing.T) {
+	alice := sdk.AccAddress("alice")
+	bob := sdk.AccAddress("bob")
+	blacklisted := sdk.AccAddress("blacklisted")
+
+	send := func(denom string) sdk.Msg {
+		return &banktypes.MsgSend{
+			FromAddress: alice.String(),
+			ToAddress:   bob.String(),
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000_000)),
+		}
+	}
+	sendTypeUrl := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	grant := func(denom string) sdk.Msg {
+		authorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000_000)), nil)
+		msg, err := authz.NewMsgGrant(alice, bob, authorization, nil)
+		require.NoError(t, err)
+		return msg
+	}
+
+	tests := []struct {
+		name  string
+		setup func(k testKeepers, ctx sdk.Context)
+		msg   sdk.Msg
+		err   error
+	}{
+		{
+			name: "allowed message is routed",
+			msg:  send("uatom"),
+		},
+		{
+			name: "tripped circuit breaker",
+			setup: func(k testKeepers, ctx sdk.Context) {
+				require.NoError(t, k.permissionsKeeper.CircuitBreakers.Set(ctx, sendTypeUrl, permissionstypes.CircuitBreaker{TypeUrl: sendTypeUrl}))
+			},
+			msg: send("uatom"),
+			err: ErrCircuitBreakerTripped,
+		},
+		{
+			name: "paused minting denom",
+			setup: func(k testKeepers, ctx sdk.Context) {
+				k.ftfKeeper.SetPaused(ctx, ftftypes.Paused{Paused: true})
+			},
+			msg: grant(mintingDenom),
+			err: ftftypes.ErrPaused,
+		},
+		{
+			name: "denied by policy",
+			setup: func(k testKeepers, ctx sdk.Context) {
+				require.NoError(t, k.permissionsKeeper.Policies.Set(ctx, sendTypeUrl, permissionstypes.Policy{
+					TypeUrl: sendTypeUrl,
+					Action:  permissionstypes.ActionDeny,
+				}))
+			},
+			msg: send("uatom"),
+			err: ErrPermissionedAction,
+		},
+		{
+			name: "blacklisted recipient",
+			setup: func(k testKeepers, ctx sdk.Context) {
+				k.ftfKeeper.SetBlacklisted(ctx, ftftypes.Blacklisted{AddressBz: blacklisted})
+			},
+			msg: &forwardingtypes.MsgRegisterAccount{
+				Signer:    alice.String(),
+				Recipient: blacklisted.String(),
+				Channel:   "channel-0",
+			},
+			err: ErrBlacklistedRecipient,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := setupKeepers(t)
+			if tc.setup != nil {
+				tc.setup(k, ctx)
+			}
+
+			inner := &mockMsgRouter{}
+			router := NewPermissionedMsgRouter(inner, k.cdc, k.ftfKeeper, k.permissionsKeeper, nil)
+
+			handler := router.Handler(tc.msg)
+			require.NotNil(t, handler)
+
+			_, err := handler(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.Empty(t, inner.routed)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.Msg{tc.msg}, inner.routed)
+			}
+		})
+	}
+}
+
+func TestPermissionedMsgRouterUnknownMessage(t *testing.T) {
+	k, _ := setupKeepers(t)
+	router := NewPermissionedMsgRouter(&mockMsgRouter{}, k.cdc, k.ftfKeeper, k.permissionsKeeper, nil)
+
+	require.Nil(t, router.Handler(&banktypes.MsgMultiSend{}))
+}
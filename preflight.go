@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
+	"github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory"
+	ftfkeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/noble/v11/preflight"
+	permissionskeeper "github.com/noble-assets/noble/v11/x/permissions/keeper"
+)
+
+// PreflightChecks returns the message level checks of all Noble specific ante
+// decorators, in the order they are executed by the ante handler.
+func PreflightChecks(cdc codec.Codec, ftfKeeper *ftfkeeper.Keeper, permissionsKeeper *permissionskeeper.Keeper, warpKeeper *warpkeeper.Keeper) []preflight.Check {
+	circuitBreaker := NewCircuitBreakerDecorator(permissionsKeeper)
+	isPaused := fiattokenfactory.NewIsPausedDecorator(cdc, ftfKeeper)
+	isBlacklisted := fiattokenfactory.NewIsBlacklistedDecorator(ftfKeeper)
+	permissioned := NewPermissionedMessagesDecorator(permissionsKeeper)
+	screening := NewRecipientScreeningDecorator(NewRecipientScreener(ftfKeeper), warpKeeper)
+
+	return []preflight.Check{
+		{
+			Decorator:    "CircuitBreakerDecorator",
+			CheckMessage: circuitBreaker.CheckMessage,
+		},
+		{
+			Decorator: "IsPausedDecorator",
+			CheckMessage: func(ctx sdk.Context, msg sdk.Msg) error {
+				return isPaused.CheckMessages(ctx, []sdk.Msg{msg})
+			},
+		},
+		{
+			Decorator: "IsBlacklistedDecorator",
+			CheckMessage: func(ctx sdk.Context, msg sdk.Msg) error {
+				return isBlacklisted.CheckMessages(ctx, []sdk.Msg{msg}, nil)
+			},
+		},
+		{
+			Decorator:    "PermissionedMessagesDecorator",
+			CheckMessage: permissioned.CheckMessage,
+		},
+		{
+			Decorator:    "RecipientScreeningDecorator",
+			CheckMessage: screening.CheckMessage,
+		},
+	}
+}
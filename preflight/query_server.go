@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflight
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// Check is a named, message level check performed by an ante decorator.
+type Check struct {
+	// Decorator is the name of the ante decorator performing the check.
+	Decorator string
+	// CheckMessage returns an error if the message is rejected.
+	CheckMessage func(ctx sdk.Context, msg sdk.Msg) error
+}
+
+var _ QueryServer = &queryServer{}
+
+type queryServer struct {
+	txDecoder sdk.TxDecoder
+	checks    []Check
+}
+
+func NewQueryServer(txDecoder sdk.TxDecoder, checks ...Check) QueryServer {
+	return &queryServer{txDecoder: txDecoder, checks: checks}
+}
+
+func (s queryServer) Preflight(ctx context.Context, req *QueryPreflight) (*QueryPreflightResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	tx, err := s.txDecoder(req.Tx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	res := &QueryPreflightResponse{Allowed: true}
+	for index, msg := range tx.GetMsgs() {
+		verdict, err := s.getVerdict(sdk.UnwrapSDKContext(ctx), index, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Allowed = res.Allowed && verdict.Allowed
+		res.Verdicts = append(res.Verdicts, verdict)
+	}
+
+	return res, nil
+}
+
+// getVerdict runs all checks against a message, recursing through authz
+// executions. Only the first rejection of a message is reported.
+func (s queryServer) getVerdict(ctx sdk.Context, index int, msg sdk.Msg) (Verdict, error) {
+	verdict := Verdict{
+		Index:   uint32(index),
+		TypeUrl: sdk.MsgTypeURL(msg),
+		Allowed: true,
+	}
+
+	for _, check := range s.checks {
+		if err := check.CheckMessage(ctx, msg); err != nil {
+			codespace, code, _ := errorsmod.ABCIInfo(err, false)
+
+			verdict.Allowed = false
+			verdict.Decorator = check.Decorator
+			verdict.Reason = err.Error()
+			verdict.Codespace = codespace
+			verdict.Code = code
+			break
+		}
+	}
+
+	if m, ok := msg.(*authz.MsgExec); ok {
+		execMsgs, err := m.GetMessages()
+		if err != nil {
+			return verdict, err
+		}
+
+		for execIndex, execMsg := range execMsgs {
+			nested, err := s.getVerdict(ctx, execIndex, execMsg)
+			if err != nil {
+				return verdict, err
+			}
+
+			verdict.Nested = append(verdict.Nested, nested)
+		}
+	}
+
+	return verdict, nil
+}
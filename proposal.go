@@ -17,40 +17,28 @@
 package noble
 
 import (
-	"context"
 	"fmt"
 	"slices"
 	"time"
 
 	"cosmossdk.io/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 
-	"connectrpc.com/connect"
-	jester "jester.noble.xyz/api"
-
-	wormholekeeper "github.com/noble-assets/wormhole/keeper"
-	wormholetypes "github.com/noble-assets/wormhole/types"
-	vaautils "github.com/wormhole-foundation/wormhole/sdk/vaa"
-
-	dollarkeeper "dollar.noble.xyz/v2/keeper"
-	dollarportaltypes "dollar.noble.xyz/v2/types/portal"
+	injectiontypes "github.com/noble-assets/noble/v11/x/injection/types"
 )
 
-// jesterIndex is the index of the injected Jester response in a block.
-const jesterIndex = 0
-
 type ProposalHandler struct {
-	txConfig client.TxConfig
-
-	jesterClient   jester.QueryServiceClient
-	wormholeServer wormholetypes.QueryServer
-	dollarKeeper   *dollarkeeper.Keeper
+	txConfig  client.TxConfig
+	injectors []Injector
 
 	defaultPrepareProposalHandler sdk.PrepareProposalHandler
+	defaultProcessProposalHandler sdk.ProcessProposalHandler
 	defaultPreBlocker             sdk.PreBlocker
 }
 
@@ -59,96 +47,162 @@ func NewProposalHandler(
 	mempool mempool.Mempool,
 	preBlocker sdk.PreBlocker,
 	txConfig client.TxConfig,
-	jesterClient jester.QueryServiceClient,
-	dollarKeeper *dollarkeeper.Keeper,
-	wormholeKeeper *wormholekeeper.Keeper,
+	injectors ...Injector,
 ) *ProposalHandler {
 	defaultHandler := baseapp.NewDefaultProposalHandler(mempool, app)
 
 	return &ProposalHandler{
-		txConfig: txConfig,
-
-		jesterClient:   jesterClient,
-		wormholeServer: wormholekeeper.NewQueryServer(wormholeKeeper),
-		dollarKeeper:   dollarKeeper,
+		txConfig:  txConfig,
+		injectors: injectors,
 
 		defaultPrepareProposalHandler: defaultHandler.PrepareProposalHandler(),
+		defaultProcessProposalHandler: defaultHandler.ProcessProposalHandler(),
 		defaultPreBlocker:             preBlocker,
 	}
 }
 
 // PrepareProposal is the logic called by the current block proposer to prepare
-// a block proposal. Noble modifies this by making a request to our sidecar
-// service, Jester, to check if there are any outstanding $USDN transfers that
-// need to be relayed to Noble. These transfers (in the form of Wormhole VAAs)
-// are injected as the first transaction of the block, and are later processed
-// by the PreBlocker handler.
+// a block proposal. Noble modifies this by running all injectors, such as the
+// one fetching outstanding $USDN transfers from our sidecar service, Jester.
+// The injected txs are placed at the start of the block, in the order of the
+// injectors, and are later processed by the PreBlocker handler.
+//
+// Each injected tx is limited by the budget of its injector. The size and gas
+// of the injected txs are reserved before selecting transactions from the
+// mempool, so that the proposal is always valid.
 func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
 		logger := ctx.Logger()
 
+		var injected [][]byte
+		for _, injector := range h.injectors {
+			name := injector.Name()
+
+			maxTxBytes, maxGas, err := injector.Budget(ctx)
+			if err != nil {
+				logger.Error("failed to get budget of injector", "injector", name, "err", err)
+				continue
+			}
+			maxTxBytes = min(maxTxBytes, req.MaxTxBytes)
+			if maxBlockGas := getMaxBlockGas(ctx); maxBlockGas > 0 {
+				maxGas = min(maxGas, maxBlockGas)
+			}
+
+			start := time.Now()
+			injection, err := injector.Fetch(ctx, req)
+			telemetry.MeasureSince(start, "injector", name, "fetch")
+			if err != nil {
+				telemetry.IncrCounter(1, "injector", name, "fetch", "errors")
+				logger.Error("failed to fetch data to inject", "injector", name, "err", err)
+				continue
+			}
+
+			bz, gas, err := injector.Encode(ctx, injection, maxTxBytes, maxGas)
+			if err != nil {
+				telemetry.IncrCounter(1, "injector", name, "encode", "errors")
+				logger.Error("failed to encode injected tx", "injector", name, "err", err)
+				continue
+			}
+			if bz == nil {
+				continue
+			}
+
+			// The request is shallow copied, so that the original is unmodified.
+			trimmed := *req
+			trimmed.MaxTxBytes -= cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})
+			req = &trimmed
+
+			ctx = reserveBlockGas(ctx, gas)
+
+			telemetry.IncrCounter(1, "injector", name, "injected")
+			injected = append(injected, bz)
+		}
+
 		res, err := h.defaultPrepareProposalHandler(ctx, req)
 		if err != nil {
 			return nil, errors.Wrap(err, "default PrepareProposal handler failed")
 		}
 
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
-		defer cancel()
-
-		request := connect.NewRequest(&jester.GetVoteExtensionRequest{})
-		jesterRes, err := h.jesterClient.GetVoteExtension(ctxWithTimeout, request)
-		if err != nil {
-			logger.Error("failed to query jester", "err", err)
-		}
+		return &abci.ResponsePrepareProposal{Txs: slices.Insert(res.Txs, 0, injected...)}, nil
+	}
+}
 
-		if jesterRes != nil && jesterRes.Msg != nil && jesterRes.Msg.Dollar != nil && len(jesterRes.Msg.Dollar.Vaas) > 0 {
-			var nonExecutedVAAs []sdk.Msg
-
-			for _, raw := range jesterRes.Msg.Dollar.Vaas {
-				vaa, err := vaautils.Unmarshal(raw)
-				if err != nil {
-					logger.Warn("failed to unmarshal transfer from jester", "err", err)
-					continue
-				}
-
-				wormholeRes, _ := h.wormholeServer.ExecutedVAA(ctx, &wormholetypes.QueryExecutedVAA{
-					Input: vaa.SigningDigest().String(),
-				})
-
-				if wormholeRes != nil && !wormholeRes.Executed {
-					nonExecutedVAAs = append(nonExecutedVAAs, &dollarportaltypes.MsgDeliverInjection{
-						Vaa: raw,
-					})
-				} else {
-					logger.Warn("skipped already executed transfer from jester", "identifier", vaa.MessageID())
-				}
+// ProcessProposal is the logic called by all validators to verify a block
+// proposal. Noble modifies this by ensuring that all txs injected at the
+// start of the block are valid for their injector, and fit within both the
+// budget of their injector and the maximum block gas. As the injected txs are
+// unsigned, they are excluded from the default verification.
+//
+// The default verification doesn't decode any txs when the mempool is
+// disabled, so all other txs are verified here to not be marked as injected,
+// e.g. by an unknown injector or out of order, and to fit within the maximum
+// block gas left after the injected txs.
+func (h *ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		var count int
+		for _, injector := range h.injectors {
+			if count >= len(req.Txs) {
+				break
 			}
+			name := injector.Name()
 
-			if len(nonExecutedVAAs) > 0 {
-				builder := h.txConfig.NewTxBuilder()
+			marker, marked := h.getInjectedTx(req.Txs[count])
+			if !marked {
+				break
+			}
+			if marker.Injector != name {
+				continue
+			}
 
-				err := builder.SetMsgs(nonExecutedVAAs...)
-				if err != nil {
-					return nil, errors.Wrap(err, "failed to set messages of injected jester tx")
-				}
+			injected, err := injector.Validate(ctx, req.Txs[count])
+			if err != nil {
+				telemetry.IncrCounter(1, "injector", name, "rejected")
+				ctx.Logger().Error("rejected proposal with invalid injected tx", "injector", name, "proposer", sdk.ConsAddress(req.ProposerAddress), "err", err)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
 
-				tx := builder.GetTx()
+			maxTxBytes, maxGas, err := injector.Budget(ctx)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get budget of injector %s", name)
+			}
 
-				bz, err := h.txConfig.TxEncoder()(tx)
-				if err != nil {
-					return nil, errors.Wrap(err, "failed to marshal injected jester tx")
-				}
-				res.Txs = slices.Insert(res.Txs, jesterIndex, bz)
+			size := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{req.Txs[count]})
+			gas := injected.GetGas()
+			if size > maxTxBytes || gas > maxGas {
+				telemetry.IncrCounter(1, "injector", name, "rejected")
+				ctx.Logger().Error("rejected proposal with injected tx exceeding budget of injector", "injector", name, "proposer", sdk.ConsAddress(req.ProposerAddress), "bytes", size, "gas", gas)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
 
-				logger.Info(fmt.Sprintf("injected %d pending transfers from jester", len(nonExecutedVAAs)))
+			if maxBlockGas := getMaxBlockGas(ctx); maxBlockGas > 0 && gas > maxBlockGas {
+				telemetry.IncrCounter(1, "injector", name, "rejected")
+				ctx.Logger().Error("rejected proposal with injected tx exceeding max block gas", "injector", name, "proposer", sdk.ConsAddress(req.ProposerAddress), "gas", gas)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
 			}
+
+			ctx = reserveBlockGas(ctx, gas)
+			count++
 		}
 
-		return &abci.ResponsePrepareProposal{Txs: res.Txs}, nil
+		if err := h.verifyTxs(ctx, req.Txs[count:]); err != nil {
+			ctx.Logger().Error("rejected proposal with invalid tx", "proposer", sdk.ConsAddress(req.ProposerAddress), "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if count > 0 {
+			// The request is shallow copied, so that the original is unmodified.
+			trimmed := *req
+			trimmed.Txs = req.Txs[count:]
+			req = &trimmed
+		}
+
+		return h.defaultProcessProposalHandler(ctx, req)
 	}
 }
 
-// PreBlocker processes all injected $USDN transfers from Jester.
+// PreBlocker processes all txs injected at the start of the block. Only txs
+// marked as injected are processed, which are later skipped when delivering
+// the block with a dedicated error code.
 func (h *ProposalHandler) PreBlocker() sdk.PreBlocker {
 	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
 		res, err := h.defaultPreBlocker(ctx, req)
@@ -156,57 +210,101 @@ func (h *ProposalHandler) PreBlocker() sdk.PreBlocker {
 			return nil, errors.Wrap(err, "default PreBlocker failed")
 		}
 
-		if len(req.Txs) == 0 {
-			return res, nil
-		}
+		var count int
+		for _, injector := range h.injectors {
+			if count >= len(req.Txs) {
+				break
+			}
+
+			marker, marked := h.getInjectedTx(req.Txs[count])
+			if !marked {
+				break
+			}
+			if marker.Injector != injector.Name() {
+				continue
+			}
 
-		tx := req.Txs[jesterIndex]
-		h.handleJesterTx(ctx, tx)
+			injector.Apply(ctx, req.Txs[count])
+			count++
+		}
 
 		return res, nil
 	}
 }
 
-// handleJesterTx is a utility that processes messages from Jester.
-func (h *ProposalHandler) handleJesterTx(ctx sdk.Context, bytes []byte) {
-	logger := ctx.Logger()
-
-	defer func() {
-		if r := recover(); r != nil {
-			logger.Error("recovered panic when handling transfers from jester", "err", r)
-		}
-	}()
-
-	tx, err := h.txConfig.TxDecoder()(bytes)
+// getInjectedTx is a utility that returns the marker of an injected tx, if
+// the tx is marked as injected.
+func (h *ProposalHandler) getInjectedTx(bz []byte) (*injectiontypes.InjectedTx, bool) {
+	tx, err := h.txConfig.TxDecoder()(bz)
 	if err != nil {
-		logger.Error("failed to unmarshal injected jester tx", "err", err)
-		return
+		return nil, false
 	}
 
-	var count int
-	for _, raw := range tx.GetMsgs() {
-		msg, ok := raw.(*dollarportaltypes.MsgDeliverInjection)
-		// If the first message is not a MsgDeliverInjection, no VAAs were injected.
-		if !ok {
-			break
-		}
+	return injectiontypes.GetInjectedTx(tx)
+}
 
-		vaa, err := vaautils.Unmarshal(msg.Vaa)
+// verifyTxs is a utility that ensures that none of the given txs is marked as
+// injected, and that their total gas doesn't exceed the maximum block gas.
+// Txs that can't be decoded are left to the default verification, as they
+// fail when delivering the block without consuming any gas.
+func (h *ProposalHandler) verifyTxs(ctx sdk.Context, txs [][]byte) error {
+	maxBlockGas := getMaxBlockGas(ctx)
+
+	var totalGas uint64
+	for _, bz := range txs {
+		tx, err := h.txConfig.TxDecoder()(bz)
 		if err != nil {
-			logger.Error("failed to unmarshal transfer from jester", "err", err)
 			continue
 		}
 
-		cachedCtx, writeCache := ctx.CacheContext()
-		if err := h.dollarKeeper.Deliver(cachedCtx, msg.Vaa); err != nil {
-			logger.Error("failed to process transfer from jester", "identifier", vaa.MessageID(), "err", err)
-		} else {
-			writeCache()
-			count++
+		if marker, marked := injectiontypes.GetInjectedTx(tx); marked {
+			return fmt.Errorf("tx marked as injected by %s outside of the injected txs", marker.Injector)
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok || maxBlockGas == 0 {
+			continue
+		}
+		if feeTx.GetGas() > maxBlockGas-totalGas {
+			return fmt.Errorf("txs exceed the remaining max block gas of %d", maxBlockGas)
 		}
+		totalGas += feeTx.GetGas()
+	}
+
+	return nil
+}
 
+// getMaxBlockGas is a utility that returns the maximum gas of a block, or
+// zero if it is unlimited.
+func getMaxBlockGas(ctx sdk.Context) uint64 {
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxGas > 0 {
+		return uint64(block.MaxGas)
 	}
-	if count > 0 {
-		logger.Info(fmt.Sprintf("processed %d transfers from jester", count))
+
+	return 0
+}
+
+// reserveBlockGas is a utility that reduces the maximum gas of a block in the
+// consensus params of a context by the given amount, so that it is reserved
+// when selecting or verifying all other txs.
+func reserveBlockGas(ctx sdk.Context, gas uint64) sdk.Context {
+	maxBlockGas := getMaxBlockGas(ctx)
+	if maxBlockGas == 0 {
+		return ctx
 	}
+
+	params := ctx.ConsensusParams()
+	block := *params.Block
+	// A maximum gas of zero is treated as unlimited, so at least one is kept.
+	block.MaxGas = int64(max(maxBlockGas-min(gas, maxBlockGas), 1))
+	params.Block = &block
+
+	return ctx.WithConsensusParams(params)
+}
+
+// voteExtensionsEnabled is a utility that returns if the vote extensions of
+// the previous height are available when proposing a given height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	params := ctx.ConsensusParams()
+	return params.Abci != nil && params.Abci.VoteExtensionsEnableHeight != 0 && height > params.Abci.VoteExtensionsEnableHeight
 }
@@ -0,0 +1,329 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	injectiontypes "github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+var _ Injector = &mockInjector{}
+
+// mockInjector is a test utility that injects a tx containing its data, and
+// records the limits it was encoded with and the txs it applied.
+type mockInjector struct {
+	txConfig client.TxConfig
+	name     string
+	data     [][]byte
+	gas      uint64
+
+	maxTxBytes int64
+	maxGas     uint64
+
+	fetchErr    error
+	validateErr error
+
+	encodedMaxTxBytes int64
+	encodedMaxGas     uint64
+	applied           [][]byte
+}
+
+func (m *mockInjector) Name() string { return m.name }
+
+func (m *mockInjector) Budget(_ sdk.Context) (int64, uint64, error) {
+	return m.maxTxBytes, m.maxGas, nil
+}
+
+func (m *mockInjector) Fetch(_ sdk.Context, _ *abci.RequestPrepareProposal) (Injection, error) {
+	return Injection{Data: m.data}, m.fetchErr
+}
+
+func (m *mockInjector) Encode(_ sdk.Context, injection Injection, maxTxBytes int64, maxGas uint64) ([]byte, uint64, error) {
+	m.encodedMaxTxBytes, m.encodedMaxGas = maxTxBytes, maxGas
+	if len(injection.Data) == 0 {
+		return nil, 0, nil
+	}
+
+	builder := m.txConfig.NewTxBuilder()
+	builder.SetGasLimit(m.gas)
+	if err := injectiontypes.MarkInjectedTx(builder, m.name, bytes.Join(injection.Data, nil)); err != nil {
+		return nil, 0, err
+	}
+
+	bz, err := m.txConfig.TxEncoder()(builder.GetTx())
+	return bz, m.gas, err
+}
+
+func (m *mockInjector) Validate(_ sdk.Context, bz []byte) (sdk.FeeTx, error) {
+	if m.validateErr != nil {
+		return nil, m.validateErr
+	}
+
+	tx, err := m.txConfig.TxDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.(sdk.FeeTx), nil
+}
+
+func (m *mockInjector) Apply(_ sdk.Context, bz []byte) {
+	m.applied = append(m.applied, bz)
+}
+
+// defaultHandlers is a test utility that records the requests reaching the
+// default proposal handlers, alongside the maximum block gas they observed.
+type defaultHandlers struct {
+	maxTxBytes  int64
+	maxBlockGas uint64
+	txs         [][]byte
+}
+
+// mockProposalHandler is a test utility that returns a ProposalHandler running
+// the given injectors, whose default handlers are recorded.
+func mockProposalHandler(txConfig client.TxConfig, defaults *defaultHandlers, injectors ...Injector) *ProposalHandler {
+	return &ProposalHandler{
+		txConfig:  txConfig,
+		injectors: injectors,
+
+		defaultPrepareProposalHandler: func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+			defaults.maxTxBytes, defaults.maxBlockGas = req.MaxTxBytes, getMaxBlockGas(ctx)
+			return &abci.ResponsePrepareProposal{Txs: [][]byte{[]byte("mempool")}}, nil
+		},
+		defaultProcessProposalHandler: func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			defaults.txs, defaults.maxBlockGas = req.Txs, getMaxBlockGas(ctx)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		},
+		defaultPreBlocker: func(_ sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+			return &sdk.ResponsePreBlock{}, nil
+		},
+	}
+}
+
+// setupProposal is a test utility that returns a context with a maximum block
+// gas, alongside two injectors with a budget and one without anything to
+// inject.
+func setupProposal(t *testing.T) (sdk.Context, client.TxConfig, *mockInjector, *mockInjector, *mockInjector) {
+	t.Helper()
+
+	keepers, ctx := setupKeepers(t)
+	ctx = ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: 1_000_000}})
+
+	first := &mockInjector{txConfig: keepers.txConfig, name: "first", data: [][]byte{[]byte("first")}, gas: 100_000, maxTxBytes: 1_000, maxGas: 200_000}
+	empty := &mockInjector{txConfig: keepers.txConfig, name: "empty", maxTxBytes: 1_000, maxGas: 200_000}
+	second := &mockInjector{txConfig: keepers.txConfig, name: "second", data: [][]byte{[]byte("second")}, gas: 300_000, maxTxBytes: 1 << 20, maxGas: 2_000_000}
+
+	return ctx, keepers.txConfig, first, empty, second
+}
+
+func TestPrepareProposal(t *testing.T) {
+	ctx, txConfig, first, empty, second := setupProposal(t)
+	failing := &mockInjector{txConfig: txConfig, name: "failing", data: [][]byte{[]byte("failing")}, maxTxBytes: 1_000, maxGas: 200_000, fetchErr: errors.New("failed")}
+
+	var defaults defaultHandlers
+	handler := mockProposalHandler(txConfig, &defaults, first, empty, failing, second).PrepareProposal()
+
+	// ACT: Prepare a proposal.
+	res, err := handler(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 10_000})
+	require.NoError(t, err)
+	firstMaxTxBytes, firstMaxGas := first.encodedMaxTxBytes, first.encodedMaxGas
+	secondMaxTxBytes, secondMaxGas := second.encodedMaxTxBytes, second.encodedMaxGas
+
+	// ASSERT: The injected txs are placed at the start of the block in the
+	// order of the injectors, skipping those that inject nothing or fail.
+	firstTx, _, err := first.Encode(ctx, Injection{Data: first.data}, 0, 0)
+	require.NoError(t, err)
+	secondTx, _, err := second.Encode(ctx, Injection{Data: second.data}, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{firstTx, secondTx, []byte("mempool")}, res.Txs)
+
+	// ASSERT: The first injector is limited by its own budget.
+	firstSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{firstTx})
+	secondSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{secondTx})
+	require.Equal(t, int64(1_000), firstMaxTxBytes)
+	require.Equal(t, uint64(200_000), firstMaxGas)
+
+	// ASSERT: The second injector is limited by the remaining block.
+	require.Equal(t, 10_000-firstSize, secondMaxTxBytes)
+	require.Equal(t, uint64(900_000), secondMaxGas)
+
+	// ASSERT: The size and gas of the injected txs are reserved.
+	require.Equal(t, 10_000-firstSize-secondSize, defaults.maxTxBytes)
+	require.Equal(t, uint64(600_000), defaults.maxBlockGas)
+}
+
+func TestProcessProposal(t *testing.T) {
+	ctx, txConfig, first, empty, second := setupProposal(t)
+
+	var defaults defaultHandlers
+	handler := mockProposalHandler(txConfig, &defaults, first, empty, second).ProcessProposal()
+
+	firstTx, _, err := first.Encode(ctx, Injection{Data: first.data}, 0, 0)
+	require.NoError(t, err)
+	secondTx, _, err := second.Encode(ctx, Injection{Data: second.data}, 0, 0)
+	require.NoError(t, err)
+
+	// ARRANGE: A proposal with all injected txs in order.
+	// ACT: Attempt to process.
+	res, err := handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, secondTx, []byte("mempool")}})
+	// ASSERT: The proposal is accepted, and only the remaining txs reach the
+	// default handler with the gas of the injected txs reserved.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+	require.Equal(t, [][]byte{[]byte("mempool")}, defaults.txs)
+	require.Equal(t, uint64(600_000), defaults.maxBlockGas)
+
+	// ARRANGE: A proposal skipping the first injector.
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{secondTx, []byte("mempool")}})
+	// ASSERT: The proposal is accepted.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+	require.Equal(t, [][]byte{[]byte("mempool")}, defaults.txs)
+
+	// ARRANGE: A proposal with the injected txs out of order.
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{secondTx, firstTx}})
+	// ASSERT: The proposal is rejected, as the out of order tx is marked as
+	// injected.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	// ARRANGE: A proposal with an invalid injected tx.
+	first.validateErr = errors.New("invalid")
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, secondTx}})
+	// ASSERT: The proposal is rejected.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+	first.validateErr = nil
+
+	// ARRANGE: A proposal with an injected tx exceeding the gas budget of
+	// its injector.
+	first.maxGas = first.gas - 1
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, secondTx}})
+	// ASSERT: The proposal is rejected.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+	first.maxGas = 200_000
+
+	// ARRANGE: A proposal with an injected tx exceeding the size budget of
+	// its injector.
+	first.maxTxBytes = cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{firstTx}) - 1
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, secondTx}})
+	// ASSERT: The proposal is rejected.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+	first.maxTxBytes = 1_000
+
+	// ARRANGE: A proposal whose injected txs exceed the maximum block gas.
+	second.gas = 950_000
+	secondTx, _, err = second.Encode(ctx, Injection{Data: second.data}, 0, 0)
+	require.NoError(t, err)
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, secondTx}})
+	// ASSERT: The proposal is rejected.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+}
+
+func TestPreBlocker(t *testing.T) {
+	ctx, txConfig, first, empty, second := setupProposal(t)
+
+	var defaults defaultHandlers
+	handler := mockProposalHandler(txConfig, &defaults, first, empty, second).PreBlocker()
+
+	firstTx, _, err := first.Encode(ctx, Injection{Data: first.data}, 0, 0)
+	require.NoError(t, err)
+	secondTx, _, err := second.Encode(ctx, Injection{Data: second.data}, 0, 0)
+	require.NoError(t, err)
+
+	// ACT: Finalize a block with all injected txs.
+	_, err = handler(ctx, &abci.RequestFinalizeBlock{Txs: [][]byte{firstTx, secondTx, []byte("mempool")}})
+	require.NoError(t, err)
+
+	// ASSERT: Each injected tx is applied by its injector, and no other txs.
+	require.Equal(t, [][]byte{firstTx}, first.applied)
+	require.Empty(t, empty.applied)
+	require.Equal(t, [][]byte{secondTx}, second.applied)
+}
+
+func TestProcessProposalWithNoOpMempool(t *testing.T) {
+	ctx, txConfig, first, empty, second := setupProposal(t)
+
+	// With a disabled mempool, the default handler accepts all proposals.
+	handler := NewProposalHandler(nil, mempool.NoOpMempool{}, nil, txConfig, first, empty, second).ProcessProposal()
+
+	firstTx, _, err := first.Encode(ctx, Injection{Data: first.data}, 0, 0)
+	require.NoError(t, err)
+	secondTx, _, err := second.Encode(ctx, Injection{Data: second.data}, 0, 0)
+	require.NoError(t, err)
+
+	encodeTx := func(gas uint64) []byte {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(&banktypes.MsgSend{}))
+		builder.SetGasLimit(gas)
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+
+	// ARRANGE: A proposal whose txs fit within the gas left after the
+	// injected txs.
+	// ACT: Attempt to process.
+	res, err := handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, secondTx, encodeTx(400_000), encodeTx(200_000)}})
+	// ASSERT: The proposal is accepted.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+	// ARRANGE: A proposal whose txs exceed the gas left after the injected
+	// txs.
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, secondTx, encodeTx(400_000), encodeTx(200_001)}})
+	// ASSERT: The proposal is rejected.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	// ARRANGE: A proposal with an injected tx after the other txs.
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, encodeTx(100_000), secondTx}})
+	// ASSERT: The proposal is rejected.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	// ARRANGE: A proposal with an injected tx repeated after the injected
+	// txs.
+	// ACT: Attempt to process.
+	res, err = handler(ctx, &abci.RequestProcessProposal{Txs: [][]byte{firstTx, secondTx, firstTx}})
+	// ASSERT: The proposal is rejected.
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+}
@@ -0,0 +1,10 @@
+cd proto
+# Module configs are generated using the standard Go plugin, as they are
+# required to implement the V2 protobuf API for dependency injection.
+buf generate --template buf.gen.gogo.yaml --exclude-path noble/feepolicy/module --exclude-path noble/injection/module --exclude-path noble/permissions/module
+buf generate --template buf.gen.go.yaml --path noble/feepolicy/module --path noble/injection/module --path noble/permissions/module
+cd ..
+
+cp -r github.com/noble-assets/noble/v11/* ./
+
+rm -rf github.com
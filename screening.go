@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	ftfkeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// orbiterRecipientKeys contains the keys of Orbiter payload fields that hold
+// the beneficiary of a cross-chain action.
+var orbiterRecipientKeys = []string{"recipient", "receiver", "mint_recipient"}
+
+// RecipientScreener screens the beneficiaries of cross-chain payloads that
+// are visible to Noble against the Fiat TokenFactory blacklist.
+type RecipientScreener struct {
+	ftfKeeper *ftfkeeper.Keeper
+}
+
+func NewRecipientScreener(ftfKeeper *ftfkeeper.Keeper) RecipientScreener {
+	return RecipientScreener{ftfKeeper: ftfKeeper}
+}
+
+// IsMintingDenom returns if a denom is the minting denom of the Fiat
+// TokenFactory, whose transfers are subject to the blacklist.
+func (s RecipientScreener) IsMintingDenom(ctx sdk.Context, denom string) bool {
+	return denom == s.ftfKeeper.GetMintingDenom(ctx).Denom
+}
+
+// ScreenMemo ensures that no beneficiary included in a memo is blacklisted.
+func (s RecipientScreener) ScreenMemo(ctx sdk.Context, memo string) error {
+	for _, recipient := range GetMemoRecipients(memo) {
+		if err := s.ScreenRecipient(ctx, recipient); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ScreenRecipient ensures that a recipient address is not blacklisted. The
+// address can either be Bech32, hex or base64 encoded. Addresses that can't
+// be decoded are not screened, as they can't be present in the blacklist.
+func (s RecipientScreener) ScreenRecipient(ctx sdk.Context, recipient string) error {
+	for _, address := range decodeRecipient(recipient) {
+		if _, found := s.ftfKeeper.GetBlacklisted(ctx, address); found {
+			return errorsmod.Wrapf(ErrBlacklistedRecipient, "%s", recipient)
+		}
+	}
+
+	return nil
+}
+
+// ScreenRecipientBytes ensures that a raw recipient address is not blacklisted.
+func (s RecipientScreener) ScreenRecipientBytes(ctx sdk.Context, recipient []byte) error {
+	for _, address := range trimRecipient(recipient) {
+		if _, found := s.ftfKeeper.GetBlacklisted(ctx, address); found {
+			return errorsmod.Wrapf(ErrBlacklistedRecipient, "0x%x", recipient)
+		}
+	}
+
+	return nil
+}
+
+// GetMemoRecipients returns all beneficiaries included in a memo, i.e. the
+// receivers of all Packet Forward Middleware hops, the recipient of a
+// Forwarding account registration, and the recipients of an Orbiter payload.
+func GetMemoRecipients(memo string) (recipients []string) {
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil
+	}
+
+	recipients = append(recipients, getForwardRecipients(fields)...)
+
+	if noble, ok := fields["noble"].(map[string]any); ok {
+		if forwarding, ok := noble["forwarding"].(map[string]any); ok {
+			if recipient, ok := forwarding["recipient"].(string); ok {
+				recipients = append(recipients, recipient)
+			}
+		}
+	}
+
+	if orbiter, ok := fields["orbiter"]; ok {
+		recipients = append(recipients, getNestedRecipients(orbiter, orbiterRecipientKeys)...)
+	}
+
+	return recipients
+}
+
+// getForwardRecipients is a utility that returns the receivers of all hops of
+// a Packet Forward Middleware memo.
+func getForwardRecipients(fields map[string]any) (recipients []string) {
+	forward, ok := fields["forward"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	if receiver, ok := forward["receiver"].(string); ok {
+		recipients = append(recipients, receiver)
+	}
+
+	// The next hop can either be a JSON object or a JSON encoded string.
+	switch next := forward["next"].(type) {
+	case map[string]any:
+		recipients = append(recipients, getForwardRecipients(next)...)
+	case string:
+		var nextFields map[string]any
+		if err := json.Unmarshal([]byte(next), &nextFields); err == nil {
+			recipients = append(recipients, getForwardRecipients(nextFields)...)
+		}
+	}
+
+	return recipients
+}
+
+// getNestedRecipients is a utility that returns the string values of all
+// given keys, at any depth of a JSON value.
+func getNestedRecipients(value any, keys []string) (recipients []string) {
+	switch value := value.(type) {
+	case map[string]any:
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			nested := value[key]
+			if recipient, ok := nested.(string); ok {
+				if slices.Contains(keys, key) {
+					recipients = append(recipients, recipient)
+				}
+				continue
+			}
+
+			recipients = append(recipients, getNestedRecipients(nested, keys)...)
+		}
+	case []any:
+		for _, nested := range value {
+			recipients = append(recipients, getNestedRecipients(nested, keys)...)
+		}
+	}
+
+	return recipients
+}
+
+// decodeRecipient is a utility that returns all possible raw addresses of a
+// Bech32, hex or base64 encoded recipient.
+func decodeRecipient(recipient string) [][]byte {
+	if _, address, err := ftfkeeper.DecodeNoLimitToBase256(recipient); err == nil {
+		return [][]byte{address}
+	}
+
+	if address, err := hex.DecodeString(strings.TrimPrefix(recipient, "0x")); err == nil {
+		return trimRecipient(address)
+	}
+
+	if address, err := base64.StdEncoding.DecodeString(recipient); err == nil {
+		return trimRecipient(address)
+	}
+
+	return nil
+}
+
+// trimRecipient is a utility that additionally returns the trailing 20 bytes
+// of a left padded 32 byte address, as used by e.g. Hyperlane and CCTP.
+func trimRecipient(address []byte) [][]byte {
+	if len(address) == 32 && bytes.Equal(address[:12], make([]byte, 12)) {
+		return [][]byte{address, address[12:]}
+	}
+
+	return [][]byte{address}
+}
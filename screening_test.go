@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	ftftypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMemoRecipients(t *testing.T) {
+	tests := []struct {
+		name     string
+		memo     string
+		expected []string
+	}{
+		{
+			name: "invalid memo",
+			memo: "hello world",
+		},
+		{
+			name:     "forward with nested object hop",
+			memo:     `{"forward":{"receiver":"first","next":{"forward":{"receiver":"second"}}}}`,
+			expected: []string{"first", "second"},
+		},
+		{
+			name:     "forward with nested string hop",
+			memo:     `{"forward":{"receiver":"first","next":"{\"forward\":{\"receiver\":\"second\"}}"}}`,
+			expected: []string{"first", "second"},
+		},
+		{
+			name:     "forwarding account",
+			memo:     `{"noble":{"forwarding":{"recipient":"recipient","channel":"channel-1"}}}`,
+			expected: []string{"recipient"},
+		},
+		{
+			name:     "orbiter payload",
+			memo:     `{"orbiter":{"actions":[{"receiver":"first"}],"forwarding":{"attributes":{"mint_recipient":"second","other":"ignored"}}}}`,
+			expected: []string{"first", "second"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, GetMemoRecipients(tc.memo))
+		})
+	}
+}
+
+func TestScreenRecipient(t *testing.T) {
+	k, ctx := setupKeepers(t)
+	screener := NewRecipientScreener(k.ftfKeeper)
+
+	blacklisted := sdk.AccAddress("blacklisted_address_")
+	k.ftfKeeper.SetBlacklisted(ctx, ftftypes.Blacklisted{AddressBz: blacklisted})
+
+	padded := append(make([]byte, 12), blacklisted...)
+
+	for _, recipient := range []string{
+		blacklisted.String(),
+		sdk.MustBech32ifyAddressBytes("osmo", blacklisted),
+		hex.EncodeToString(blacklisted),
+		"0x" + hex.EncodeToString(padded),
+		base64.StdEncoding.EncodeToString(padded),
+	} {
+		require.ErrorIs(t, screener.ScreenRecipient(ctx, recipient), ErrBlacklistedRecipient, recipient)
+	}
+
+	require.ErrorIs(t, screener.ScreenRecipientBytes(ctx, padded), ErrBlacklistedRecipient)
+	require.NoError(t, screener.ScreenRecipient(ctx, sdk.AccAddress("allowed_address_____").String()))
+	require.NoError(t, screener.ScreenRecipient(ctx, "not an address"))
+
+	memo := `{"forward":{"receiver":"cosmos1receiver","next":{"forward":{"receiver":"` + blacklisted.String() + `"}}}}`
+	require.ErrorIs(t, screener.ScreenMemo(ctx, memo), ErrBlacklistedRecipient)
+}
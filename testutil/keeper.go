@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testutil
+
+import (
+	"testing"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// Authority is the authority of the Noble modules in tests.
+var Authority = sdk.AccAddress("authority").String()
+
+// NewKeeperFn is the constructor shared by the keepers of the Noble modules.
+type NewKeeperFn[K any] func(authority string, cdc codec.Codec, storeService store.KVStoreService, eventService event.Service) K
+
+// SetupKeeper is a test utility that returns the keeper of a Noble module
+// backed by an in-memory store, alongside a context for that store.
+func SetupKeeper[K any](t *testing.T, moduleName string, newKeeper NewKeeperFn[K]) (K, sdk.Context) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(moduleName)
+	ctx := sdktestutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cfg := moduletestutil.MakeTestEncodingConfig()
+
+	k := newKeeper(Authority, cfg.Codec, runtime.NewKVStoreService(key), runtime.EventService{})
+
+	return k, ctx
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultWindow         = 10
+	defaultMaxPerWindow   = 1
+	defaultMaxPerBlock    = 100
+	defaultGas            = 50_000
+	defaultThrottleEnable = true
+)
+
+// Config defines the throttle applied in CheckTx to transactions that are
+// signed by a forwarding account, and therefore don't pay for a signature.
+type Config struct {
+	// Enabled defines if the throttle is applied.
+	Enabled bool
+	// Window is the number of blocks in which a single forwarding account can
+	// submit at most MaxPerWindow transactions.
+	Window int64
+	// MaxPerWindow is the maximum number of transactions of a single
+	// forwarding account per window. Zero disables the limit.
+	MaxPerWindow uint64
+	// MaxPerBlock is the maximum number of transactions of all forwarding
+	// accounts per block. Zero disables the limit.
+	MaxPerBlock uint64
+	// Gas is the flat amount of gas charged per transaction.
+	Gas uint64
+}
+
+// DefaultConfig returns the default throttle configuration.
+func DefaultConfig() Config {
+	return Config{
+		Enabled:      defaultThrottleEnable,
+		Window:       defaultWindow,
+		MaxPerWindow: defaultMaxPerWindow,
+		MaxPerBlock:  defaultMaxPerBlock,
+		Gas:          defaultGas,
+	}
+}
+
+// NewConfig reads the throttle configuration from the application options.
+func NewConfig(appOpts servertypes.AppOptions) Config {
+	return Config{
+		Enabled:      cast.ToBool(appOpts.Get(FlagEnabled)),
+		Window:       cast.ToInt64(appOpts.Get(FlagWindow)),
+		MaxPerWindow: cast.ToUint64(appOpts.Get(FlagMaxPerWindow)),
+		MaxPerBlock:  cast.ToUint64(appOpts.Get(FlagMaxPerBlock)),
+		Gas:          cast.ToUint64(appOpts.Get(FlagGas)),
+	}
+}
+
+// ConfigTemplate is the app.toml section of the forwarding throttle.
+var ConfigTemplate = fmt.Sprintf(`
+###############################################################################
+###                           Forwarding Throttle                           ###
+###############################################################################
+
+[forwarding-throttle]
+
+# Defines if transactions signed by forwarding accounts, which don't require a
+# signature, are throttled when entering the mempool.
+enabled = %t
+
+# Number of blocks in which a single forwarding account can submit at most
+# max-per-window transactions.
+window = %d
+
+# Maximum number of transactions of a single forwarding account per window.
+# Set to 0 to disable the limit.
+max-per-window = %d
+
+# Maximum number of transactions of all forwarding accounts per block.
+# Set to 0 to disable the limit.
+max-per-block = %d
+
+# Flat amount of gas charged per transaction when entering the mempool.
+gas = %d
+`, defaultThrottleEnable, defaultWindow, defaultMaxPerWindow, defaultMaxPerBlock, defaultGas)
+
+// Flags
+
+const (
+	FlagEnabled      = "forwarding-throttle.enabled"
+	FlagWindow       = "forwarding-throttle.window"
+	FlagMaxPerWindow = "forwarding-throttle.max-per-window"
+	FlagMaxPerBlock  = "forwarding-throttle.max-per-block"
+	FlagGas          = "forwarding-throttle.gas"
+)
+
+func AddFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagEnabled, defaultThrottleEnable, "Throttle transactions signed by forwarding accounts")
+	cmd.Flags().Int64(FlagWindow, defaultWindow, "Number of blocks per forwarding account throttle window")
+	cmd.Flags().Uint64(FlagMaxPerWindow, defaultMaxPerWindow, "Maximum transactions per forwarding account per window")
+	cmd.Flags().Uint64(FlagMaxPerBlock, defaultMaxPerBlock, "Maximum transactions of all forwarding accounts per block")
+	cmd.Flags().Uint64(FlagGas, defaultGas, "Flat gas charged per forwarding account transaction")
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttle
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// Throttle keeps track of the transactions of forwarding accounts that have
+// been accepted into the mempool. As it only applies to CheckTx, all state
+// is kept in memory and is not part of consensus.
+type Throttle struct {
+	config Config
+
+	mu         sync.Mutex
+	height     int64
+	blockCount uint64
+	accounts   map[string][]int64
+}
+
+func New(config Config) *Throttle {
+	return &Throttle{
+		config:   config,
+		accounts: make(map[string][]int64),
+	}
+}
+
+// Config returns the configuration of the throttle.
+func (t *Throttle) Config() Config {
+	return t.config
+}
+
+// Check returns an error if a transaction of the given forwarding account
+// can't be accepted at the given height.
+func (t *Throttle) Check(height int64, account string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.advance(height)
+
+	if t.config.MaxPerBlock > 0 && t.blockCount >= t.config.MaxPerBlock {
+		telemetry.IncrCounter(1, "forwarding", "throttle", "rejected", "max_per_block")
+		return fmt.Errorf("exceeded maximum of %d transactions per block", t.config.MaxPerBlock)
+	}
+
+	if t.config.MaxPerWindow > 0 && uint64(len(t.accounts[account])) >= t.config.MaxPerWindow {
+		telemetry.IncrCounter(1, "forwarding", "throttle", "rejected", "max_per_window")
+		return fmt.Errorf("exceeded maximum of %d transactions per %d blocks for %s", t.config.MaxPerWindow, t.config.Window, account)
+	}
+
+	return nil
+}
+
+// Record records an accepted transaction of the given forwarding account at
+// the given height.
+func (t *Throttle) Record(height int64, account string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.advance(height)
+
+	t.blockCount++
+	t.accounts[account] = append(t.accounts[account], height)
+
+	telemetry.IncrCounter(1, "forwarding", "throttle", "accepted")
+	telemetry.SetGauge(float32(t.blockCount), "forwarding", "throttle", "block_count")
+}
+
+// advance is an internal helper that resets the block counter and prunes all
+// records outside the window once a new height is reached.
+func (t *Throttle) advance(height int64) {
+	if height == t.height {
+		return
+	}
+
+	t.height = height
+	t.blockCount = 0
+
+	for account, heights := range t.accounts {
+		index := 0
+		for index < len(heights) && heights[index] <= height-t.config.Window {
+			index++
+		}
+
+		if index == len(heights) {
+			delete(t.accounts, account)
+		} else {
+			t.accounts[account] = heights[index:]
+		}
+	}
+
+	telemetry.SetGauge(float32(len(t.accounts)), "forwarding", "throttle", "accounts")
+}
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	tmclient "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// ClientKeeper defines the subset of the IBC client keeper used to recover
+// clients.
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+	GetClientStatus(ctx sdk.Context, clientState ibcexported.ClientState, clientID string) ibcexported.Status
+	RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error
+}
+
+// ClientRecovery defines the recovery of an expired or frozen IBC light client,
+// by substituting its state with that of an active client tracking the same
+// counterparty chain.
+//
+// In IBC-Go v8.7.0, the MsgRecoverClient message does not support the
+// LegacyAminoJSON signing mode, preventing recovery via the Noble Maintenance
+// Multisig. As a result, expired clients must be recovered as part of a
+// software upgrade.
+type ClientRecovery struct {
+	// SubjectClientID is the identifier of the expired or frozen client.
+	SubjectClientID string
+	// SubstituteClientID is the identifier of the active client.
+	SubstituteClientID string
+	// CounterpartyChainID is the chain ID both clients are expected to track.
+	CounterpartyChainID string
+}
+
+// Validate checks that the client recovery is well-formed.
+func (r ClientRecovery) Validate() error {
+	if err := host.ClientIdentifierValidator(r.SubjectClientID); err != nil {
+		return fmt.Errorf("invalid subject client: %w", err)
+	}
+	if err := host.ClientIdentifierValidator(r.SubstituteClientID); err != nil {
+		return fmt.Errorf("invalid substitute client: %w", err)
+	}
+	if r.SubjectClientID == r.SubstituteClientID {
+		return fmt.Errorf("subject and substitute client are both %s", r.SubjectClientID)
+	}
+	if r.CounterpartyChainID == "" {
+		return errors.New("counterparty chain id must not be empty")
+	}
+
+	return nil
+}
+
+// RecoverClients recovers a set of IBC light clients. Every recovery is only
+// executed if the subject client is expired or frozen, and the substitute
+// client is active, with both tracking the expected counterparty chain. In
+// strict mode, the first failed recovery is returned, otherwise failures are
+// logged and skipped.
+func RecoverClients(ctx sdk.Context, logger log.Logger, clientKeeper ClientKeeper, upgrade string, recoveries []ClientRecovery, strict bool) error {
+	for _, recovery := range recoveries {
+		// The recovery is executed in a cache, so that a failed recovery
+		// doesn't leave behind partial state when it is skipped.
+		cachedCtx, writeCache := ctx.CacheContext()
+
+		err := recoverClient(cachedCtx, clientKeeper, recovery)
+		if err != nil {
+			if strict {
+				return fmt.Errorf("failed to recover client %s for %s: %w", recovery.SubjectClientID, recovery.CounterpartyChainID, err)
+			}
+
+			logger.Error("failed to recover client", "subject", recovery.SubjectClientID, "substitute", recovery.SubstituteClientID, "chain", recovery.CounterpartyChainID, "err", err)
+			if err := ctx.EventManager().EmitTypedEvent(&ClientRecoveryFailed{
+				Upgrade:             upgrade,
+				SubjectClientId:     recovery.SubjectClientID,
+				SubstituteClientId:  recovery.SubstituteClientID,
+				CounterpartyChainId: recovery.CounterpartyChainID,
+				Error:               err.Error(),
+			}); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		writeCache()
+
+		logger.Info("recovered client", "subject", recovery.SubjectClientID, "substitute", recovery.SubstituteClientID, "chain", recovery.CounterpartyChainID)
+		if err := ctx.EventManager().EmitTypedEvent(&ClientRecovered{
+			Upgrade:             upgrade,
+			SubjectClientId:     recovery.SubjectClientID,
+			SubstituteClientId:  recovery.SubstituteClientID,
+			CounterpartyChainId: recovery.CounterpartyChainID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recoverClient is an internal helper that checks and executes a single
+// client recovery.
+func recoverClient(ctx sdk.Context, clientKeeper ClientKeeper, recovery ClientRecovery) error {
+	subjectStatus, err := getClientStatus(ctx, clientKeeper, recovery.SubjectClientID, recovery.CounterpartyChainID)
+	if err != nil {
+		return err
+	}
+	if subjectStatus != ibcexported.Expired && subjectStatus != ibcexported.Frozen {
+		return fmt.Errorf("subject client %s is %s, expected %s or %s", recovery.SubjectClientID, subjectStatus, ibcexported.Expired, ibcexported.Frozen)
+	}
+
+	substituteStatus, err := getClientStatus(ctx, clientKeeper, recovery.SubstituteClientID, recovery.CounterpartyChainID)
+	if err != nil {
+		return err
+	}
+	if substituteStatus != ibcexported.Active {
+		return fmt.Errorf("substitute client %s is %s, expected %s", recovery.SubstituteClientID, substituteStatus, ibcexported.Active)
+	}
+
+	return clientKeeper.RecoverClient(ctx, recovery.SubjectClientID, recovery.SubstituteClientID)
+}
+
+// getClientStatus is an internal helper that returns the status of a client,
+// after checking that it tracks the expected counterparty chain.
+func getClientStatus(ctx sdk.Context, clientKeeper ClientKeeper, clientID string, chainID string) (ibcexported.Status, error) {
+	clientState, found := clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return "", fmt.Errorf("client %s not found", clientID)
+	}
+
+	tmClientState, ok := clientState.(*tmclient.ClientState)
+	if !ok {
+		return "", fmt.Errorf("client %s is %s, expected %s", clientID, clientState.ClientType(), ibcexported.Tendermint)
+	}
+	if tmClientState.ChainId != chainID {
+		return "", fmt.Errorf("client %s tracks %s, expected %s", clientID, tmClientState.ChainId, chainID)
+	}
+
+	return clientKeeper.GetClientStatus(ctx, clientState, clientID), nil
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	tmclient "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+)
+
+var _ ClientKeeper = &mockClientKeeper{}
+
+// mockClient is a test utility that defines the state of a client.
+type mockClient struct {
+	chainID string
+	status  ibcexported.Status
+}
+
+// mockClientKeeper is a test utility that serves a set of Tendermint clients,
+// and records all recovered clients.
+type mockClientKeeper struct {
+	clients   map[string]mockClient
+	recovered [][2]string
+}
+
+func (k *mockClientKeeper) GetClientState(_ sdk.Context, clientID string) (ibcexported.ClientState, bool) {
+	client, found := k.clients[clientID]
+	if !found {
+		return nil, false
+	}
+
+	return &tmclient.ClientState{ChainId: client.chainID}, true
+}
+
+func (k *mockClientKeeper) GetClientStatus(_ sdk.Context, _ ibcexported.ClientState, clientID string) ibcexported.Status {
+	return k.clients[clientID].status
+}
+
+func (k *mockClientKeeper) RecoverClient(_ sdk.Context, subjectClientID, substituteClientID string) error {
+	if subjectClientID == "07-tendermint-99" {
+		return errors.New("failed to recover")
+	}
+
+	k.recovered = append(k.recovered, [2]string{subjectClientID, substituteClientID})
+	return nil
+}
+
+// newMockClientKeeper is a test utility that returns a client keeper with an
+// expired, frozen, and active client of a counterparty chain, and an active
+// client of another chain.
+func newMockClientKeeper() *mockClientKeeper {
+	return &mockClientKeeper{clients: map[string]mockClient{
+		"07-tendermint-0":  {chainID: "coreum-mainnet-1", status: ibcexported.Expired},
+		"07-tendermint-1":  {chainID: "coreum-mainnet-1", status: ibcexported.Frozen},
+		"07-tendermint-2":  {chainID: "coreum-mainnet-1", status: ibcexported.Active},
+		"07-tendermint-3":  {chainID: "osmosis-1", status: ibcexported.Active},
+		"07-tendermint-99": {chainID: "coreum-mainnet-1", status: ibcexported.Expired},
+	}}
+}
+
+func TestRecoverClient(t *testing.T) {
+	tests := []struct {
+		name       string
+		subject    string
+		substitute string
+		err        string
+	}{
+		{name: "expired subject", subject: "07-tendermint-0", substitute: "07-tendermint-2"},
+		{name: "frozen subject", subject: "07-tendermint-1", substitute: "07-tendermint-2"},
+		{name: "active subject", subject: "07-tendermint-2", substitute: "07-tendermint-2", err: "is Active, expected Expired or Frozen"},
+		{name: "inactive substitute", subject: "07-tendermint-0", substitute: "07-tendermint-1", err: "is Frozen, expected Active"},
+		{name: "mismatched subject chain", subject: "07-tendermint-3", substitute: "07-tendermint-2", err: "tracks osmosis-1, expected coreum-mainnet-1"},
+		{name: "mismatched substitute chain", subject: "07-tendermint-0", substitute: "07-tendermint-3", err: "tracks osmosis-1, expected coreum-mainnet-1"},
+		{name: "unknown subject", subject: "07-tendermint-4", substitute: "07-tendermint-2", err: "not found"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientKeeper := newMockClientKeeper()
+
+			err := recoverClient(sdk.Context{}, clientKeeper, ClientRecovery{
+				SubjectClientID:     tc.subject,
+				SubstituteClientID:  tc.substitute,
+				CounterpartyChainID: "coreum-mainnet-1",
+			})
+
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				require.Empty(t, clientKeeper.recovered)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, [][2]string{{tc.subject, tc.substitute}}, clientKeeper.recovered)
+			}
+		})
+	}
+}
+
+func TestRecoverClients(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	recoveries := []ClientRecovery{
+		{SubjectClientID: "07-tendermint-2", SubstituteClientID: "07-tendermint-0", CounterpartyChainID: "coreum-mainnet-1"},
+		{SubjectClientID: "07-tendermint-99", SubstituteClientID: "07-tendermint-2", CounterpartyChainID: "coreum-mainnet-1"},
+		{SubjectClientID: "07-tendermint-0", SubstituteClientID: "07-tendermint-2", CounterpartyChainID: "coreum-mainnet-1"},
+	}
+
+	// ACT: Recover a set of clients in strict mode.
+	clientKeeper := newMockClientKeeper()
+	err := RecoverClients(ctx, log.NewNopLogger(), clientKeeper, UpgradeName, recoveries, true)
+
+	// ASSERT: The first failed recovery aborts.
+	require.ErrorContains(t, err, "failed to recover client 07-tendermint-2")
+	require.Empty(t, clientKeeper.recovered)
+
+	// ACT: Recover a set of clients.
+	clientKeeper = newMockClientKeeper()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = RecoverClients(ctx, log.NewNopLogger(), clientKeeper, UpgradeName, recoveries, false)
+
+	// ASSERT: Failed recoveries are skipped, and an event is emitted for
+	// every recovery.
+	require.NoError(t, err)
+	require.Equal(t, [][2]string{{"07-tendermint-0", "07-tendermint-2"}}, clientKeeper.recovered)
+
+	var failed, recovered []string
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+
+		switch msg := msg.(type) {
+		case *ClientRecoveryFailed:
+			failed = append(failed, msg.SubjectClientId)
+		case *ClientRecovered:
+			recovered = append(recovered, msg.SubjectClientId)
+		}
+	}
+	require.Equal(t, []string{"07-tendermint-2", "07-tendermint-99"}, failed)
+	require.Equal(t, []string{"07-tendermint-0"}, recovered)
+}
+
+func TestUpgrades(t *testing.T) {
+	// ASSERT: All software upgrades, including their client recoveries, are
+	// valid.
+	require.NoError(t, ValidateUpgrades(Upgrades))
+
+	// ASSERT: The Coreum client, already recovered in v11.5.0, isn't
+	// recovered again.
+	upgrade, found := GetUpgrade(UpgradeName)
+	require.True(t, found)
+	require.Empty(t, upgrade.ClientRecoveries)
+}
@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	feepolicytypes "github.com/noble-assets/noble/v11/x/feepolicy/types"
+	injectiontypes "github.com/noble-assets/noble/v11/x/injection/types"
+	permissionstypes "github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+// Keepers contains the keepers used when executing software upgrades.
+type Keepers struct {
+	ClientKeeper ClientKeeper
+}
+
+// HandlerCreator returns the handler of a software upgrade.
+type HandlerCreator func(mm *module.Manager, cfg module.Configurator) upgradetypes.UpgradeHandler
+
+// Upgrade defines a software upgrade used on-chain.
+type Upgrade struct {
+	// Name is the name of the upgrade, as used in the on-chain plan.
+	Name string
+	// CreateHandler returns the handler of the upgrade. If nil, the handler
+	// only runs the migrations of all modules.
+	CreateHandler HandlerCreator
+	// StoreUpgrades are the module stores added, renamed, and deleted during
+	// the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+	// ChainIDs are the networks targeted by the upgrade. If empty, the upgrade
+	// targets all networks.
+	ChainIDs []string
+	// ClientRecoveries are the IBC light clients recovered after the handler
+	// is executed, keyed by the chain ID of the network.
+	ClientRecoveries map[string][]ClientRecovery
+	// StrictClientRecovery aborts the upgrade if any client recovery fails,
+	// instead of skipping it.
+	StrictClientRecovery bool
+}
+
+// Upgrades contains all software upgrades supported by this binary.
+var Upgrades = []Upgrade{
+	{
+		Name:          UpgradeName,
+		CreateHandler: CreateUpgradeHandler,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added: []string{feepolicytypes.ModuleName, injectiontypes.ModuleName, permissionstypes.ModuleName},
+		},
+	},
+}
+
+// GetUpgrade returns the software upgrade with the provided name.
+func GetUpgrade(name string) (Upgrade, bool) {
+	for _, upgrade := range Upgrades {
+		if upgrade.Name == name {
+			return upgrade, true
+		}
+	}
+
+	return Upgrade{}, false
+}
+
+// ValidateUpgrades checks that all software upgrades are uniquely named, and
+// that their store upgrades don't conflict.
+func ValidateUpgrades(upgrades []Upgrade) error {
+	names := make(map[string]bool)
+	for _, upgrade := range upgrades {
+		if upgrade.Name == "" {
+			return errors.New("upgrade name must not be empty")
+		}
+		if names[upgrade.Name] {
+			return fmt.Errorf("duplicate upgrade %s", upgrade.Name)
+		}
+		names[upgrade.Name] = true
+
+		if err := upgrade.validateStoreUpgrades(); err != nil {
+			return fmt.Errorf("invalid store upgrades of %s: %w", upgrade.Name, err)
+		}
+
+		if err := upgrade.validateClientRecoveries(); err != nil {
+			return fmt.Errorf("invalid client recoveries of %s: %w", upgrade.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Targets returns whether the software upgrade targets the provided network.
+func (u Upgrade) Targets(chainID string) bool {
+	return len(u.ChainIDs) == 0 || slices.Contains(u.ChainIDs, chainID)
+}
+
+// Handler returns the handler of the software upgrade, that fails if executed
+// on a network not targeted by the upgrade. Once the handler is executed, the
+// IBC light clients of the network are recovered.
+func (u Upgrade) Handler(mm *module.Manager, cfg module.Configurator, logger log.Logger, keepers Keepers) upgradetypes.UpgradeHandler {
+	var handler upgradetypes.UpgradeHandler
+	if u.CreateHandler != nil {
+		handler = u.CreateHandler(mm, cfg)
+	} else {
+		handler = func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			return mm.RunMigrations(ctx, cfg, vm)
+		}
+	}
+
+	return func(ctx context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		chainID := sdkCtx.ChainID()
+		if !u.Targets(chainID) {
+			return vm, fmt.Errorf("upgrade %s does not target %s", u.Name, chainID)
+		}
+
+		logger.Info("executing upgrade", "name", u.Name, "height", plan.Height)
+		vm, err := handler(ctx, plan, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		return vm, RecoverClients(sdkCtx, logger, keepers.ClientKeeper, u.Name, u.ClientRecoveries[chainID], u.StrictClientRecovery)
+	}
+}
+
+// validateClientRecoveries is an internal helper that checks that all client
+// recoveries are well-formed, and that every client is only recovered once.
+func (u Upgrade) validateClientRecoveries() error {
+	for chainID, recoveries := range u.ClientRecoveries {
+		if chainID == "" {
+			return errors.New("chain id must not be empty")
+		}
+		if !u.Targets(chainID) {
+			return fmt.Errorf("%s is not targeted", chainID)
+		}
+
+		subjects := make(map[string]bool)
+		for _, recovery := range recoveries {
+			if err := recovery.Validate(); err != nil {
+				return fmt.Errorf("invalid recovery on %s: %w", chainID, err)
+			}
+			if subjects[recovery.SubjectClientID] {
+				return fmt.Errorf("client %s is recovered more than once on %s", recovery.SubjectClientID, chainID)
+			}
+			subjects[recovery.SubjectClientID] = true
+		}
+	}
+
+	return nil
+}
+
+// validateStoreUpgrades is an internal helper that checks that every module
+// store is only added, renamed, or deleted once.
+func (u Upgrade) validateStoreUpgrades() error {
+	stores := make(map[string]bool)
+	use := func(name string) error {
+		if name == "" {
+			return errors.New("store name must not be empty")
+		}
+		if stores[name] {
+			return fmt.Errorf("store %s is upgraded more than once", name)
+		}
+		stores[name] = true
+
+		return nil
+	}
+
+	for _, name := range u.StoreUpgrades.Added {
+		if err := use(name); err != nil {
+			return err
+		}
+	}
+	for _, rename := range u.StoreUpgrades.Renamed {
+		if err := use(rename.OldKey); err != nil {
+			return err
+		}
+		if err := use(rename.NewKey); err != nil {
+			return err
+		}
+	}
+	for _, name := range u.StoreUpgrades.Deleted {
+		if err := use(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
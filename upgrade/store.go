@@ -22,8 +22,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 )
 
-func CreateStoreLoader(upgradeHeight int64) baseapp.StoreLoader {
-	storeUpgrades := storetypes.StoreUpgrades{}
-
+// CreateStoreLoader returns a store loader that applies the store upgrades of
+// a software upgrade at the upgrade height.
+func CreateStoreLoader(upgradeHeight int64, storeUpgrades storetypes.StoreUpgrades) baseapp.StoreLoader {
 	return upgradetypes.UpgradeStoreLoader(upgradeHeight, &storeUpgrades)
 }
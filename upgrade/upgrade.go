@@ -19,39 +19,18 @@ package upgrade
 import (
 	"context"
 
-	"cosmossdk.io/log"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	clientkeeper "github.com/cosmos/ibc-go/v8/modules/core/02-client/keeper"
 )
 
+// CreateUpgradeHandler returns the handler of the v11.6.0 upgrade.
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	cfg module.Configurator,
-	logger log.Logger,
-	clientKeeper clientkeeper.Keeper,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
-		vm, err := mm.RunMigrations(ctx, cfg, vm)
-		if err != nil {
-			return vm, err
-		}
-
-		sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-		// In IBC-Go v8.7.0, the MsgRecoverClient message does not support the
-		// LegacyAminoJSON signing mode, preventing recovery via the Noble
-		// Maintenance Multisig. As a result, expired clients on mainnet must
-		// be manually recovered as part of a software upgrade.
-		if sdkCtx.ChainID() == MainnetChainID {
-			// Substitute the IBC light client for the coreum-mainnet-1 chain.
-			err = clientKeeper.RecoverClient(sdkCtx, "07-tendermint-71", "07-tendermint-226")
-			if err != nil {
-				logger.Error("failed to recover coreum-mainnet-1 client", "error", err)
-			}
-		}
-
-		return vm, nil
+		// NOTE: The IBC light clients listed in the ClientRecoveries of this
+		// upgrade are recovered by Upgrade.Handler, after this handler.
+		return mm.RunMigrations(ctx, cfg, vm)
 	}
 }
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	wormholekeeper "github.com/noble-assets/wormhole/keeper"
+)
+
+// archivingWormholeKeeper wraps the Wormhole module's keeper with lookups of
+// multiple digests in its archive of executed VAAs.
+type archivingWormholeKeeper struct {
+	*wormholekeeper.Keeper
+}
+
+// HasExecuted returns for a list of VAA digests whether each of them was
+// already executed. Each unique digest is looked up individually in the
+// archive, once and in key order, as the store offers no batched lookups.
+func (k archivingWormholeKeeper) HasExecuted(ctx context.Context, digests [][]byte) ([]bool, error) {
+	unique := slices.Clone(digests)
+	slices.SortFunc(unique, bytes.Compare)
+	unique = slices.CompactFunc(unique, bytes.Equal)
+
+	executed := make(map[string]bool, len(unique))
+	for _, digest := range unique {
+		has, err := k.VAAArchive.Has(ctx, digest)
+		if err != nil {
+			return nil, err
+		}
+		executed[string(digest)] = has
+	}
+
+	res := make([]bool, len(digests))
+	for index, digest := range digests {
+		res[index] = executed[string(digest)]
+	}
+
+	return res, nil
+}
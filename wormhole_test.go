@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noble
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasExecuted(t *testing.T) {
+	keepers, ctx := setupKeepers(t)
+	k := archivingWormholeKeeper{keepers.wormholeKeeper}
+
+	executed, pending := []byte("executed"), []byte("pending")
+	require.NoError(t, k.VAAArchive.Set(ctx, executed, collections.Join("executed", true)))
+
+	// ACT: Look up executed, pending, and duplicate digests.
+	res, err := k.HasExecuted(ctx, [][]byte{pending, executed, pending, executed})
+
+	// ASSERT: The result matches the order of the digests.
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, true, false, true}, res)
+
+	// ACT: Look up no digests.
+	res, err = k.HasExecuted(ctx, nil)
+
+	// ASSERT: The result is empty.
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
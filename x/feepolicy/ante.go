@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feepolicy
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/noble-assets/globalfee"
+	globalfeekeeper "github.com/noble-assets/globalfee/keeper"
+
+	"github.com/noble-assets/noble/v11/x/feepolicy/keeper"
+)
+
+// TxFeeChecker returns a custom ante.TxFeeChecker that applies the fee
+// policies of this module around the GlobalFee module's fee checker.
+//
+// Transactions containing messages with min fees have to pay at least the sum
+// of those in one of the denoms. Transactions made only of messages with a
+// discount, and whose gas limit doesn't exceed the gas ceiling, have the
+// gas price based fee discounted, or fully waived.
+func TxFeeChecker(keeper *keeper.Keeper, globalFeeKeeper *globalfeekeeper.Keeper) ante.TxFeeChecker {
+	checker := globalfee.TxFeeChecker(globalFeeKeeper)
+
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		if !ctx.IsCheckTx() {
+			return checker(ctx, tx)
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+		fees := feeTx.GetFee()
+
+		minFees, discount, err := keeper.GetFeeRequirements(ctx, feeTx.GetMsgs())
+		if err != nil {
+			return nil, 0, err
+		}
+		if !minFees.IsZero() && !hasSufficientFees(fees, minFees) {
+			return nil, 0, errors.Wrapf(sdkerrors.ErrInsufficientFee, "expected at least one of %s", minFees)
+		}
+
+		gasCeiling, err := keeper.GasCeiling.Get(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		if discount.IsZero() || feeTx.GetGas() > gasCeiling {
+			return checker(ctx, tx)
+		}
+
+		requiredFees, err := globalFeeKeeper.GetRequiredFees(ctx, feeTx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		discountedFees := sdk.NewCoins()
+		for _, requiredFee := range requiredFees {
+			amount := requiredFee.Amount.ToLegacyDec().Mul(sdkmath.LegacyOneDec().Sub(discount)).Ceil().TruncateInt()
+			discountedFees = discountedFees.Add(sdk.NewCoin(requiredFee.Denom, amount))
+		}
+
+		if !discountedFees.IsZero() && !hasSufficientFees(fees, discountedFees) {
+			return nil, 0, errors.Wrapf(sdkerrors.ErrInsufficientFee, "expected at least one of %s", discountedFees)
+		}
+
+		// NOTE: The fees were checked against the discounted required fees
+		// above, so the GlobalFee module's fee checker is only used outside of
+		// CheckTx, where it returns the fees alongside their priority.
+		return checker(ctx.WithIsCheckTx(false), tx)
+	}
+}
+
+// hasSufficientFees is a utility that returns if the fees of a transaction
+// cover the required fees in at least one denom.
+func hasSufficientFees(fees sdk.Coins, requiredFees sdk.Coins) bool {
+	for _, fee := range fees {
+		found, requiredFee := requiredFees.Find(fee.Denom)
+		if found && fee.Amount.GTE(requiredFee.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feepolicy_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	globalfeekeeper "github.com/noble-assets/globalfee/keeper"
+	globalfeetypes "github.com/noble-assets/globalfee/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v11/x/feepolicy"
+	"github.com/noble-assets/noble/v11/x/feepolicy/keeper"
+	"github.com/noble-assets/noble/v11/x/feepolicy/types"
+)
+
+func TestTxFeeChecker(t *testing.T) {
+	keys := storetypes.NewKVStoreKeys(types.ModuleName, globalfeetypes.ModuleName)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil).WithIsCheckTx(true)
+	cfg := moduletestutil.MakeTestEncodingConfig()
+	authority := sdk.AccAddress("authority").String()
+
+	k := keeper.NewKeeper(authority, cfg.Codec, runtime.NewKVStoreService(keys[types.ModuleName]), runtime.EventService{})
+	require.NoError(t, k.GasCeiling.Set(ctx, types.DefaultGasCeiling))
+	for _, feePolicy := range []types.FeePolicy{
+		{TypeUrl: sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{}), Discount: math.LegacyOneDec()},
+		{TypeUrl: sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{}), Discount: math.LegacyNewDecWithPrec(5, 1)},
+		{
+			TypeUrl:  sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+			MinFees:  sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000)),
+			Discount: math.LegacyZeroDec(),
+		},
+	} {
+		require.NoError(t, k.FeePolicies.Set(ctx, feePolicy.TypeUrl, feePolicy))
+	}
+
+	globalFeeKeeper := globalfeekeeper.NewKeeper(authority, cfg.InterfaceRegistry, runtime.NewKVStoreService(keys[globalfeetypes.ModuleName]), cfg.Codec)
+	require.NoError(t, globalFeeKeeper.GasPrices.Set(ctx, globalfeetypes.GasPrices{
+		Value: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uusdc", math.LegacyNewDecWithPrec(1, 1))),
+	}))
+
+	checker := feepolicy.TxFeeChecker(k, globalFeeKeeper)
+
+	tests := []struct {
+		name    string
+		msg     sdk.Msg
+		gas     uint64
+		fee     int64
+		checkTx bool
+		err     error
+	}{
+		{
+			name:    "exempt message",
+			msg:     &clienttypes.MsgUpdateClient{},
+			gas:     1_000_000,
+			checkTx: true,
+		},
+		{
+			name:    "exempt message above gas ceiling",
+			msg:     &clienttypes.MsgUpdateClient{},
+			gas:     types.DefaultGasCeiling + 1,
+			checkTx: true,
+			err:     sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "discounted message",
+			msg:     &channeltypes.MsgRecvPacket{},
+			gas:     1_000_000,
+			fee:     50_000,
+			checkTx: true,
+		},
+		{
+			name:    "discounted message with priority",
+			msg:     &channeltypes.MsgRecvPacket{},
+			gas:     1_000_000,
+			fee:     2_000_000,
+			checkTx: true,
+		},
+		{
+			name:    "discounted message with insufficient fee",
+			msg:     &channeltypes.MsgRecvPacket{},
+			gas:     1_000_000,
+			fee:     49_999,
+			checkTx: true,
+			err:     sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "message with min fees",
+			msg:     &banktypes.MsgMultiSend{},
+			gas:     1_000_000,
+			fee:     1_000_000,
+			checkTx: true,
+		},
+		{
+			name:    "message with insufficient min fees",
+			msg:     &banktypes.MsgMultiSend{},
+			gas:     1_000_000,
+			fee:     999_999,
+			checkTx: true,
+			err:     sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "message without fee policy",
+			msg:     &banktypes.MsgSend{},
+			gas:     1_000_000,
+			fee:     99_999,
+			checkTx: true,
+			err:     sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "fee policies are only applied in CheckTx",
+			msg:  &banktypes.MsgMultiSend{},
+			gas:  1_000_000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := cfg.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg))
+			builder.SetGasLimit(tc.gas)
+			builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uusdc", tc.fee)))
+
+			_, priority, err := checker(ctx.WithIsCheckTx(tc.checkTx), builder.GetTx())
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.fee/int64(tc.gas), priority)
+			}
+		})
+	}
+}
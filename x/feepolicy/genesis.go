@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feepolicy
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v11/x/feepolicy/keeper"
+	"github.com/noble-assets/noble/v11/x/feepolicy/types"
+)
+
+func InitGenesis(ctx context.Context, k *keeper.Keeper, genesis types.GenesisState) {
+	for _, feePolicy := range genesis.FeePolicies {
+		if err := k.FeePolicies.Set(ctx, feePolicy.TypeUrl, feePolicy); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.GasCeiling.Set(ctx, genesis.GasCeiling); err != nil {
+		panic(err)
+	}
+}
+
+func ExportGenesis(ctx context.Context, k *keeper.Keeper) *types.GenesisState {
+	feePolicies, err := k.GetFeePolicies(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gasCeiling, err := k.GasCeiling.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		FeePolicies: feePolicies,
+		GasCeiling:  gasCeiling,
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/noble-assets/noble/v11/x/feepolicy/types"
+)
+
+type Keeper struct {
+	authority    string
+	cdc          codec.Codec
+	eventService event.Service
+
+	Schema      collections.Schema
+	FeePolicies collections.Map[string, types.FeePolicy]
+	GasCeiling  collections.Item[uint64]
+}
+
+func NewKeeper(
+	authority string,
+	cdc codec.Codec,
+	storeService store.KVStoreService,
+	eventService event.Service,
+) *Keeper {
+	builder := collections.NewSchemaBuilder(storeService)
+
+	keeper := &Keeper{
+		authority:    authority,
+		cdc:          cdc,
+		eventService: eventService,
+
+		FeePolicies: collections.NewMap(builder, types.FeePolicyPrefix, "fee_policies", collections.StringKey, codec.CollValue[types.FeePolicy](cdc)),
+		GasCeiling:  collections.NewItem(builder, types.GasCeilingKey, "gas_ceiling", collections.Uint64Value),
+	}
+
+	schema, err := builder.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	keeper.Schema = schema
+	return keeper
+}
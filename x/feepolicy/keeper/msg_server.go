@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	"github.com/noble-assets/noble/v11/x/feepolicy/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+func NewMsgServer(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) SetFeePolicy(ctx context.Context, msg *types.MsgSetFeePolicy) (*types.MsgSetFeePolicyResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := msg.FeePolicy.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidFeePolicy, err.Error())
+	}
+
+	if err := k.FeePolicies.Set(ctx, msg.FeePolicy.TypeUrl, msg.FeePolicy); err != nil {
+		return nil, errors.Wrap(err, "failed to set fee policy in state")
+	}
+
+	return &types.MsgSetFeePolicyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.FeePolicyUpdated{
+		FeePolicy: msg.FeePolicy,
+	})
+}
+
+func (k msgServer) RemoveFeePolicy(ctx context.Context, msg *types.MsgRemoveFeePolicy) (*types.MsgRemoveFeePolicyResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	has, err := k.FeePolicies.Has(ctx, msg.TypeUrl)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get fee policy from state")
+	}
+	if !has {
+		return nil, errors.Wrapf(types.ErrFeePolicyNotFound, "%s", msg.TypeUrl)
+	}
+
+	if err := k.FeePolicies.Remove(ctx, msg.TypeUrl); err != nil {
+		return nil, errors.Wrap(err, "failed to remove fee policy from state")
+	}
+
+	return &types.MsgRemoveFeePolicyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.FeePolicyRemoved{
+		TypeUrl: msg.TypeUrl,
+	})
+}
+
+func (k msgServer) SetGasCeiling(ctx context.Context, msg *types.MsgSetGasCeiling) (*types.MsgSetGasCeilingResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	oldGasCeiling, err := k.GasCeiling.Get(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get gas ceiling from state")
+	}
+
+	if err := k.GasCeiling.Set(ctx, msg.GasCeiling); err != nil {
+		return nil, errors.Wrap(err, "failed to set gas ceiling in state")
+	}
+
+	return &types.MsgSetGasCeilingResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.GasCeilingUpdated{
+		OldGasCeiling: oldGasCeiling,
+		NewGasCeiling: msg.GasCeiling,
+	})
+}
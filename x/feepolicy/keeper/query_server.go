@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/noble/v11/x/feepolicy/types"
+)
+
+var _ types.QueryServer = &queryServer{}
+
+type queryServer struct {
+	*Keeper
+}
+
+func NewQueryServer(keeper *Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+func (k queryServer) FeePolicies(ctx context.Context, req *types.QueryFeePolicies) (*types.QueryFeePoliciesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	feePolicies, err := k.GetFeePolicies(ctx)
+
+	return &types.QueryFeePoliciesResponse{FeePolicies: feePolicies}, err
+}
+
+func (k queryServer) FeePolicy(ctx context.Context, req *types.QueryFeePolicy) (*types.QueryFeePolicyResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	feePolicy, err := k.Keeper.FeePolicies.Get(ctx, req.TypeUrl)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrFeePolicyNotFound
+		}
+
+		return nil, err
+	}
+
+	return &types.QueryFeePolicyResponse{FeePolicy: feePolicy}, nil
+}
+
+func (k queryServer) GasCeiling(ctx context.Context, req *types.QueryGasCeiling) (*types.QueryGasCeilingResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	gasCeiling, err := k.Keeper.GasCeiling.Get(ctx)
+
+	return &types.QueryGasCeilingResponse{GasCeiling: gasCeiling}, err
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/noble/v11/x/feepolicy/types"
+)
+
+// GetFeePolicies is a utility that returns all fee policies from state.
+func (k *Keeper) GetFeePolicies(ctx context.Context) ([]types.FeePolicy, error) {
+	var feePolicies []types.FeePolicy
+
+	err := k.FeePolicies.Walk(ctx, nil, func(_ string, feePolicy types.FeePolicy) (stop bool, err error) {
+		feePolicies = append(feePolicies, feePolicy)
+		return false, nil
+	})
+
+	return feePolicies, err
+}
+
+// GetFeeRequirements returns the fee requirements of a set of messages, i.e.
+// the sum of the min fees of all messages, and the discount applied to the
+// gas price based fee. A discount is only applied if all messages have one,
+// in which case the smallest discount is returned.
+func (k *Keeper) GetFeeRequirements(ctx context.Context, msgs []sdk.Msg) (minFees sdk.Coins, discount math.LegacyDec, err error) {
+	minFees = sdk.NewCoins()
+	discount = math.LegacyZeroDec()
+
+	for index, msg := range msgs {
+		feePolicy, err := k.FeePolicies.Get(ctx, sdk.MsgTypeURL(msg))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				// Messages without a fee policy pay the full fee.
+				feePolicy = types.FeePolicy{Discount: math.LegacyZeroDec()}
+			} else {
+				return nil, math.LegacyDec{}, err
+			}
+		}
+
+		minFees = minFees.Add(feePolicy.MinFees...)
+
+		if index == 0 || feePolicy.Discount.LT(discount) {
+			discount = feePolicy.Discount
+		}
+	}
+
+	return minFees, discount, nil
+}
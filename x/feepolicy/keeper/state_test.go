@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v11/testutil"
+	"github.com/noble-assets/noble/v11/x/feepolicy/keeper"
+	"github.com/noble-assets/noble/v11/x/feepolicy/types"
+)
+
+func TestGetFeeRequirements(t *testing.T) {
+	k, ctx := testutil.SetupKeeper(t, types.ModuleName, keeper.NewKeeper)
+
+	for _, feePolicy := range []types.FeePolicy{
+		{TypeUrl: sdk.MsgTypeURL(&clienttypes.MsgUpdateClient{}), Discount: math.LegacyOneDec()},
+		{TypeUrl: sdk.MsgTypeURL(&channeltypes.MsgRecvPacket{}), Discount: math.LegacyNewDecWithPrec(5, 1)},
+		{
+			TypeUrl:  sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+			MinFees:  sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100)),
+			Discount: math.LegacyZeroDec(),
+		},
+	} {
+		require.NoError(t, k.FeePolicies.Set(ctx, feePolicy.TypeUrl, feePolicy))
+	}
+
+	tests := []struct {
+		name     string
+		msgs     []sdk.Msg
+		minFees  sdk.Coins
+		discount math.LegacyDec
+	}{
+		{
+			name:     "exempt message",
+			msgs:     []sdk.Msg{&clienttypes.MsgUpdateClient{}},
+			minFees:  sdk.NewCoins(),
+			discount: math.LegacyOneDec(),
+		},
+		{
+			name:     "smallest discount is applied",
+			msgs:     []sdk.Msg{&clienttypes.MsgUpdateClient{}, &channeltypes.MsgRecvPacket{}},
+			minFees:  sdk.NewCoins(),
+			discount: math.LegacyNewDecWithPrec(5, 1),
+		},
+		{
+			name:     "message without fee policy",
+			msgs:     []sdk.Msg{&clienttypes.MsgUpdateClient{}, &banktypes.MsgSend{}},
+			minFees:  sdk.NewCoins(),
+			discount: math.LegacyZeroDec(),
+		},
+		{
+			name:     "min fees are summed",
+			msgs:     []sdk.Msg{&banktypes.MsgMultiSend{}, &banktypes.MsgMultiSend{}},
+			minFees:  sdk.NewCoins(sdk.NewInt64Coin("uusdc", 200)),
+			discount: math.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			minFees, discount, err := k.GetFeeRequirements(ctx, tc.msgs)
+			require.NoError(t, err)
+			require.Equal(t, tc.minFees, minFees)
+			require.True(t, tc.discount.Equal(discount), "expected %s, got %s", tc.discount, discount)
+		})
+	}
+}
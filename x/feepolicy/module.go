@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package feepolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	modulev1 "github.com/noble-assets/noble/v11/api/feepolicy/module/v1"
+	"github.com/noble-assets/noble/v11/x/feepolicy/keeper"
+	"github.com/noble-assets/noble/v11/x/feepolicy/types"
+)
+
+const ConsensusVersion = 1
+
+var (
+	_ module.AppModuleBasic      = AppModule{}
+	_ appmodule.AppModule        = AppModule{}
+	_ module.HasConsensusVersion = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.HasServices         = AppModule{}
+)
+
+//
+
+type AppModuleBasic struct{}
+
+func NewAppModuleBasic() AppModuleBasic {
+	return AppModuleBasic{}
+}
+
+func (AppModuleBasic) Name() string { return types.ModuleName }
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+func (AppModuleBasic) RegisterInterfaces(reg codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
+}
+
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genesis types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return genesis.Validate()
+}
+
+//
+
+type AppModule struct {
+	AppModuleBasic
+
+	keeper *keeper.Keeper
+}
+
+func NewAppModule(keeper *keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: NewAppModuleBasic(),
+		keeper:         keeper,
+	}
+}
+
+func (AppModule) IsOnePerModuleType() {}
+
+func (AppModule) IsAppModule() {}
+
+func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
+
+func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) {
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &genesis)
+
+	InitGenesis(ctx, m.keeper, genesis)
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genesis := ExportGenesis(ctx, m.keeper)
+	return cdc.MustMarshalJSON(genesis)
+}
+
+func (m AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(m.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(m.keeper))
+}
+
+//
+
+func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.MsgServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SetFeePolicy",
+					Use:            "set-fee-policy [fee-policy]",
+					Short:          "Set the fee policy of a message type",
+					Example:        `set-fee-policy '{"type_url":"/ibc.core.channel.v1.MsgRecvPacket","min_fees":[],"discount":"1"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fee_policy"}},
+				},
+				{
+					RpcMethod:      "RemoveFeePolicy",
+					Use:            "remove-fee-policy [type-url]",
+					Short:          "Remove the fee policy of a message type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "type_url"}},
+				},
+				{
+					RpcMethod:      "SetGasCeiling",
+					Use:            "set-gas-ceiling [gas-ceiling]",
+					Short:          "Set the maximum gas limit of a transaction for discounts to be applied",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "gas_ceiling"}},
+				},
+			},
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.QueryServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "FeePolicies",
+					Use:       "fee-policies",
+					Short:     "Query all active fee policies",
+				},
+				{
+					RpcMethod:      "FeePolicy",
+					Use:            "fee-policy [type-url]",
+					Short:          "Query the fee policy of a message type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "type_url"}},
+				},
+				{
+					RpcMethod: "GasCeiling",
+					Use:       "gas-ceiling",
+					Short:     "Query the maximum gas limit of a transaction for discounts to be applied",
+				},
+			},
+		},
+	}
+}
+
+//
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
+	EventService event.Service
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	Keeper *keeper.Keeper
+	Module appmodule.AppModule
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	if in.Config.Authority == "" {
+		panic("authority for FeePolicy module must be set")
+	}
+
+	authority := authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	k := keeper.NewKeeper(
+		authority.String(),
+		in.Cdc,
+		in.StoreService,
+		in.EventService,
+	)
+	m := NewAppModule(k)
+
+	return ModuleOutputs{Keeper: k, Module: m}
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidAuthority  = errors.Register(ModuleName, 1, "signer is not authority")
+	ErrInvalidFeePolicy  = errors.Register(ModuleName, 2, "fee policy is invalid")
+	ErrFeePolicyNotFound = errors.Register(ModuleName, 3, "fee policy not found")
+)
@@ -0,0 +1,692 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/feepolicy/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeePolicyUpdated is emitted whenever a message fee policy is set.
+type FeePolicyUpdated struct {
+	// fee_policy is the updated fee policy.
+	FeePolicy FeePolicy `protobuf:"bytes,1,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy"`
+}
+
+func (m *FeePolicyUpdated) Reset()         { *m = FeePolicyUpdated{} }
+func (m *FeePolicyUpdated) String() string { return proto.CompactTextString(m) }
+func (*FeePolicyUpdated) ProtoMessage()    {}
+func (*FeePolicyUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631290d43ee89898, []int{0}
+}
+func (m *FeePolicyUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePolicyUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePolicyUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePolicyUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePolicyUpdated.Merge(m, src)
+}
+func (m *FeePolicyUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePolicyUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePolicyUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePolicyUpdated proto.InternalMessageInfo
+
+func (m *FeePolicyUpdated) GetFeePolicy() FeePolicy {
+	if m != nil {
+		return m.FeePolicy
+	}
+	return FeePolicy{}
+}
+
+// FeePolicyRemoved is emitted whenever a message fee policy is removed.
+type FeePolicyRemoved struct {
+	// type_url is the type URL of the removed fee policy.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+}
+
+func (m *FeePolicyRemoved) Reset()         { *m = FeePolicyRemoved{} }
+func (m *FeePolicyRemoved) String() string { return proto.CompactTextString(m) }
+func (*FeePolicyRemoved) ProtoMessage()    {}
+func (*FeePolicyRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631290d43ee89898, []int{1}
+}
+func (m *FeePolicyRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePolicyRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePolicyRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePolicyRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePolicyRemoved.Merge(m, src)
+}
+func (m *FeePolicyRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePolicyRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePolicyRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePolicyRemoved proto.InternalMessageInfo
+
+func (m *FeePolicyRemoved) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+// GasCeilingUpdated is emitted whenever the gas ceiling is updated.
+type GasCeilingUpdated struct {
+	// old_gas_ceiling is the previous gas ceiling.
+	OldGasCeiling uint64 `protobuf:"varint,1,opt,name=old_gas_ceiling,json=oldGasCeiling,proto3" json:"old_gas_ceiling,omitempty"`
+	// new_gas_ceiling is the updated gas ceiling.
+	NewGasCeiling uint64 `protobuf:"varint,2,opt,name=new_gas_ceiling,json=newGasCeiling,proto3" json:"new_gas_ceiling,omitempty"`
+}
+
+func (m *GasCeilingUpdated) Reset()         { *m = GasCeilingUpdated{} }
+func (m *GasCeilingUpdated) String() string { return proto.CompactTextString(m) }
+func (*GasCeilingUpdated) ProtoMessage()    {}
+func (*GasCeilingUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631290d43ee89898, []int{2}
+}
+func (m *GasCeilingUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasCeilingUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasCeilingUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasCeilingUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasCeilingUpdated.Merge(m, src)
+}
+func (m *GasCeilingUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasCeilingUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasCeilingUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasCeilingUpdated proto.InternalMessageInfo
+
+func (m *GasCeilingUpdated) GetOldGasCeiling() uint64 {
+	if m != nil {
+		return m.OldGasCeiling
+	}
+	return 0
+}
+
+func (m *GasCeilingUpdated) GetNewGasCeiling() uint64 {
+	if m != nil {
+		return m.NewGasCeiling
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FeePolicyUpdated)(nil), "noble.feepolicy.v1.FeePolicyUpdated")
+	proto.RegisterType((*FeePolicyRemoved)(nil), "noble.feepolicy.v1.FeePolicyRemoved")
+	proto.RegisterType((*GasCeilingUpdated)(nil), "noble.feepolicy.v1.GasCeilingUpdated")
+}
+
+func init() { proto.RegisterFile("noble/feepolicy/v1/events.proto", fileDescriptor_631290d43ee89898) }
+
+var fileDescriptor_631290d43ee89898 = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0x13, 0x29, 0x6a, 0x57, 0x44, 0x0d, 0x1e, 0xb4, 0xe0, 0x56, 0x72, 0x10, 0x2f, 0xdd,
+	0x25, 0xf6, 0x0d, 0x2a, 0xe8, 0x49, 0x90, 0x40, 0x3d, 0x78, 0x09, 0xf9, 0x33, 0x89, 0x81, 0x6d,
+	0x26, 0x64, 0xb7, 0xa9, 0x7d, 0x0b, 0x1f, 0xab, 0xc7, 0x1e, 0x3d, 0x89, 0x24, 0x2f, 0x22, 0xd9,
+	0xd0, 0xa6, 0xa2, 0xb7, 0x9d, 0x99, 0xdf, 0xf7, 0xed, 0xcc, 0x47, 0x86, 0x19, 0x06, 0x02, 0x78,
+	0x0c, 0x90, 0xa3, 0x48, 0xc3, 0x25, 0x2f, 0x1d, 0x0e, 0x25, 0x64, 0x4a, 0xb2, 0xbc, 0x40, 0x85,
+	0x96, 0xa5, 0x01, 0xb6, 0x05, 0x58, 0xe9, 0x0c, 0xce, 0x13, 0x4c, 0x50, 0x8f, 0x79, 0xf3, 0x6a,
+	0xc9, 0x81, 0xfd, 0x8f, 0x55, 0x27, 0xd3, 0x8c, 0xfd, 0x42, 0x4e, 0x1f, 0x00, 0x9e, 0x75, 0x6b,
+	0x9a, 0x47, 0xbe, 0x82, 0xc8, 0x9a, 0x10, 0x12, 0x03, 0x78, 0x2d, 0x77, 0x61, 0x5e, 0x9b, 0xb7,
+	0x47, 0x77, 0x57, 0xec, 0xef, 0xb7, 0x6c, 0xab, 0x9c, 0xf4, 0x56, 0x5f, 0x43, 0xc3, 0xed, 0xc7,
+	0x9b, 0x86, 0x3d, 0xda, 0xf1, 0x75, 0x61, 0x86, 0x25, 0x44, 0xd6, 0x25, 0x39, 0x54, 0xcb, 0x1c,
+	0xbc, 0x79, 0x21, 0xb4, 0x6b, 0xdf, 0x3d, 0x68, 0xea, 0x69, 0x21, 0xec, 0x90, 0x9c, 0x3d, 0xfa,
+	0xf2, 0x1e, 0x52, 0x91, 0x66, 0xc9, 0x66, 0x8f, 0x1b, 0x72, 0x82, 0x22, 0xf2, 0x12, 0x5f, 0x7a,
+	0x61, 0x3b, 0xd1, 0xb2, 0x9e, 0x7b, 0x8c, 0x22, 0xea, 0xf0, 0x86, 0xcb, 0x60, 0xf1, 0x8b, 0xdb,
+	0x6b, 0xb9, 0x0c, 0x16, 0x1d, 0x37, 0x79, 0x5a, 0x55, 0xd4, 0x5c, 0x57, 0xd4, 0xfc, 0xae, 0xa8,
+	0xf9, 0x51, 0x53, 0x63, 0x5d, 0x53, 0xe3, 0xb3, 0xa6, 0xc6, 0xeb, 0x38, 0x49, 0xd5, 0xdb, 0x3c,
+	0x60, 0x21, 0xce, 0xb8, 0xbe, 0x73, 0xe4, 0x4b, 0x09, 0x4a, 0xb6, 0x05, 0x2f, 0x1d, 0x87, 0xbf,
+	0xef, 0xe4, 0xd8, 0x6c, 0x2d, 0x83, 0x7d, 0x9d, 0xe0, 0xf8, 0x67, 0x00, 0x4b, 0xf5, 0xc7, 0xfb,
+	0xb2, 0x01, 0x00, 0x00,
+}
+
+func (m *FeePolicyUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePolicyUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePolicyUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePolicyRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePolicyRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePolicyRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasCeilingUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasCeilingUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasCeilingUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewGasCeiling != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewGasCeiling))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldGasCeiling != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldGasCeiling))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeePolicyUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePolicy.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *FeePolicyRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *GasCeilingUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldGasCeiling != 0 {
+		n += 1 + sovEvents(uint64(m.OldGasCeiling))
+	}
+	if m.NewGasCeiling != 0 {
+		n += 1 + sovEvents(uint64(m.NewGasCeiling))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeePolicyUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePolicyUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePolicyUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePolicyRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePolicyRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePolicyRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasCeilingUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasCeilingUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasCeilingUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldGasCeiling", wireType)
+			}
+			m.OldGasCeiling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldGasCeiling |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewGasCeiling", wireType)
+			}
+			m.NewGasCeiling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewGasCeiling |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultGasCeiling is the default maximum gas limit of a transaction for
+// discounts to be applied.
+const DefaultGasCeiling = 2_000_000
+
+// Validate performs a stateless validation of a fee policy.
+func (feePolicy FeePolicy) Validate() error {
+	if !strings.HasPrefix(feePolicy.TypeUrl, "/") {
+		return fmt.Errorf("type url %s must start with /", feePolicy.TypeUrl)
+	}
+
+	if err := feePolicy.MinFees.Validate(); err != nil {
+		return fmt.Errorf("invalid min fees: %w", err)
+	}
+
+	if feePolicy.Discount.IsNil() || feePolicy.Discount.IsNegative() || feePolicy.Discount.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("discount must be between 0 and 1")
+	}
+
+	if feePolicy.MinFees.IsZero() && feePolicy.Discount.IsZero() {
+		return fmt.Errorf("fee policy must either define min fees or a discount")
+	}
+
+	return nil
+}
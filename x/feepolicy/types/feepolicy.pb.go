@@ -0,0 +1,451 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/feepolicy/v1/feepolicy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeePolicy defines the fee rules applied to a message type, on top of the
+// gas prices defined in the GlobalFee module.
+type FeePolicy struct {
+	// type_url is the exact type URL of the messages this policy applies to.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// min_fees is an optional flat fee charged for every occurrence of this
+	// message in a transaction. It can be paid in any of the listed denoms.
+	MinFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=min_fees,json=minFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fees"`
+	// discount is the share of the gas price based fee that is waived for
+	// transactions made only of messages with a discount, where one fully
+	// exempts the message. The smallest discount of all messages is applied.
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+}
+
+func (m *FeePolicy) Reset()         { *m = FeePolicy{} }
+func (m *FeePolicy) String() string { return proto.CompactTextString(m) }
+func (*FeePolicy) ProtoMessage()    {}
+func (*FeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7cd52a06b31c6df3, []int{0}
+}
+func (m *FeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePolicy.Merge(m, src)
+}
+func (m *FeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePolicy proto.InternalMessageInfo
+
+func (m *FeePolicy) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *FeePolicy) GetMinFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FeePolicy)(nil), "noble.feepolicy.v1.FeePolicy")
+}
+
+func init() {
+	proto.RegisterFile("noble/feepolicy/v1/feepolicy.proto", fileDescriptor_7cd52a06b31c6df3)
+}
+
+var fileDescriptor_7cd52a06b31c6df3 = []byte{
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x93, 0x2b, 0x5c, 0x35, 0x77, 0x75, 0x43, 0x17, 0x6a, 0x21, 0x8a, 0x2b, 0x29, 0x38,
+	0x43, 0x2a, 0x7d, 0x01, 0x2b, 0xae, 0x2a, 0x14, 0xa1, 0x9b, 0x6e, 0x24, 0x19, 0x8f, 0x71, 0x30,
+	0xc9, 0x11, 0x67, 0x0c, 0xf5, 0x2d, 0xfa, 0x18, 0xa5, 0xab, 0x2e, 0xfa, 0x10, 0x2e, 0xa5, 0xab,
+	0xd2, 0x85, 0x2d, 0x71, 0xd1, 0xd7, 0x28, 0x99, 0x19, 0xaa, 0x9b, 0x64, 0xfe, 0xf3, 0x9f, 0xf3,
+	0xcf, 0xc7, 0x1c, 0xa7, 0x9d, 0x62, 0x18, 0x03, 0x9d, 0x01, 0x2c, 0x31, 0xe6, 0x6c, 0x43, 0x33,
+	0xff, 0x28, 0xc8, 0x72, 0x85, 0x12, 0x5d, 0x57, 0xf5, 0x90, 0x63, 0x39, 0xf3, 0x1b, 0xff, 0x83,
+	0x84, 0xa7, 0x48, 0xd5, 0x57, 0xb7, 0x35, 0x3c, 0x86, 0x22, 0x41, 0x41, 0xc3, 0x40, 0x00, 0xcd,
+	0xfc, 0x10, 0x64, 0xe0, 0x53, 0x86, 0x3c, 0x35, 0x7e, 0x5d, 0xfb, 0x13, 0xa5, 0xa8, 0x16, 0xc6,
+	0x3a, 0x8b, 0x30, 0x42, 0x5d, 0x2f, 0x4e, 0xba, 0xda, 0xce, 0x6d, 0xa7, 0x3a, 0x04, 0xb8, 0x55,
+	0x97, 0xba, 0x75, 0xa7, 0x22, 0x37, 0x4b, 0x98, 0xac, 0x57, 0x71, 0xcd, 0x6e, 0xd9, 0x9d, 0xea,
+	0xb8, 0x5c, 0xe8, 0xbb, 0x55, 0xec, 0x2e, 0x9c, 0x4a, 0xc2, 0xd3, 0xc9, 0x0c, 0x40, 0xd4, 0xfe,
+	0xb4, 0x4a, 0x9d, 0x7f, 0x97, 0x75, 0x62, 0xf2, 0x0b, 0x18, 0x62, 0x60, 0xc8, 0x35, 0xf2, 0xb4,
+	0x7f, 0xb5, 0xdd, 0x37, 0xad, 0xe7, 0xcf, 0x66, 0x27, 0xe2, 0x72, 0xbe, 0x0e, 0x09, 0xc3, 0xc4,
+	0xc0, 0x98, 0x5f, 0x57, 0x4c, 0x17, 0xb4, 0x88, 0x16, 0x6a, 0x40, 0x3c, 0x7d, 0xbf, 0x5c, 0xd8,
+	0xe3, 0x72, 0xc2, 0xd3, 0x21, 0x80, 0x70, 0x47, 0x4e, 0x65, 0xca, 0x05, 0xc3, 0x75, 0x2a, 0x6b,
+	0xa5, 0x82, 0xa3, 0xef, 0x17, 0x89, 0x1f, 0xfb, 0xe6, 0xb9, 0x9e, 0x17, 0xd3, 0x05, 0xe1, 0x48,
+	0x93, 0x40, 0xce, 0xc9, 0x0d, 0x44, 0x01, 0xdb, 0x0c, 0x80, 0xbd, 0xbd, 0x76, 0x1d, 0x83, 0x34,
+	0x00, 0x36, 0xfe, 0x8d, 0xe8, 0x8f, 0xb6, 0xb9, 0x67, 0xef, 0x72, 0xcf, 0xfe, 0xca, 0x3d, 0xfb,
+	0xf1, 0xe0, 0x59, 0xbb, 0x83, 0x67, 0xbd, 0x1f, 0x3c, 0xeb, 0xbe, 0x77, 0x02, 0xa8, 0x36, 0xd0,
+	0x0d, 0x84, 0x00, 0x29, 0xb4, 0xa0, 0x99, 0xef, 0xd3, 0x87, 0x93, 0xc5, 0x29, 0xe2, 0xf0, 0xaf,
+	0x7a, 0xba, 0xde, 0xcf, 0x00, 0x5c, 0xf9, 0xa7, 0x3f, 0xd8, 0x01, 0x00, 0x00,
+}
+
+func (m *FeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeepolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MinFees) > 0 {
+		for iNdEx := len(m.MinFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintFeepolicy(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeepolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeepolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeepolicy(uint64(l))
+	}
+	if len(m.MinFees) > 0 {
+		for _, e := range m.MinFees {
+			l = e.Size()
+			n += 1 + l + sovFeepolicy(uint64(l))
+		}
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovFeepolicy(uint64(l))
+	return n
+}
+
+func sovFeepolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeepolicy(x uint64) (n int) {
+	return sovFeepolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeepolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFees = append(m.MinFees, types.Coin{})
+			if err := m.MinFees[len(m.MinFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeepolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeepolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeepolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeepolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeepolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeepolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeepolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeepolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeepolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeepolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeepolicy = fmt.Errorf("proto: unexpected end of group")
+)
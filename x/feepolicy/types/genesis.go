@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+)
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		FeePolicies: []FeePolicy{},
+		GasCeiling:  DefaultGasCeiling,
+	}
+}
+
+func (genesis *GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, feePolicy := range genesis.FeePolicies {
+		if seen[feePolicy.TypeUrl] {
+			return fmt.Errorf("duplicate fee policy for %s", feePolicy.TypeUrl)
+		}
+		seen[feePolicy.TypeUrl] = true
+
+		if err := feePolicy.Validate(); err != nil {
+			return errors.Wrapf(err, "failed to validate fee policy for %s", feePolicy.TypeUrl)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+const ModuleName = "feepolicy"
+
+const (
+	MsgServiceName   = "noble.feepolicy.v1.Msg"
+	QueryServiceName = "noble.feepolicy.v1.Query"
+)
+
+var (
+	FeePolicyPrefix = []byte("fee_policy/")
+	GasCeilingKey   = []byte("gas_ceiling")
+)
@@ -0,0 +1,1241 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/feepolicy/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryFeePolicies struct {
+}
+
+func (m *QueryFeePolicies) Reset()         { *m = QueryFeePolicies{} }
+func (m *QueryFeePolicies) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePolicies) ProtoMessage()    {}
+func (*QueryFeePolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6571af6e35d5d95a, []int{0}
+}
+func (m *QueryFeePolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePolicies.Merge(m, src)
+}
+func (m *QueryFeePolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePolicies proto.InternalMessageInfo
+
+type QueryFeePoliciesResponse struct {
+	FeePolicies []FeePolicy `protobuf:"bytes,1,rep,name=fee_policies,json=feePolicies,proto3" json:"fee_policies"`
+}
+
+func (m *QueryFeePoliciesResponse) Reset()         { *m = QueryFeePoliciesResponse{} }
+func (m *QueryFeePoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePoliciesResponse) ProtoMessage()    {}
+func (*QueryFeePoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6571af6e35d5d95a, []int{1}
+}
+func (m *QueryFeePoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePoliciesResponse.Merge(m, src)
+}
+func (m *QueryFeePoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryFeePoliciesResponse) GetFeePolicies() []FeePolicy {
+	if m != nil {
+		return m.FeePolicies
+	}
+	return nil
+}
+
+type QueryFeePolicy struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+}
+
+func (m *QueryFeePolicy) Reset()         { *m = QueryFeePolicy{} }
+func (m *QueryFeePolicy) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePolicy) ProtoMessage()    {}
+func (*QueryFeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6571af6e35d5d95a, []int{2}
+}
+func (m *QueryFeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePolicy.Merge(m, src)
+}
+func (m *QueryFeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePolicy proto.InternalMessageInfo
+
+func (m *QueryFeePolicy) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+type QueryFeePolicyResponse struct {
+	FeePolicy FeePolicy `protobuf:"bytes,1,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy"`
+}
+
+func (m *QueryFeePolicyResponse) Reset()         { *m = QueryFeePolicyResponse{} }
+func (m *QueryFeePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePolicyResponse) ProtoMessage()    {}
+func (*QueryFeePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6571af6e35d5d95a, []int{3}
+}
+func (m *QueryFeePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePolicyResponse.Merge(m, src)
+}
+func (m *QueryFeePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeePolicyResponse proto.InternalMessageInfo
+
+func (m *QueryFeePolicyResponse) GetFeePolicy() FeePolicy {
+	if m != nil {
+		return m.FeePolicy
+	}
+	return FeePolicy{}
+}
+
+type QueryGasCeiling struct {
+}
+
+func (m *QueryGasCeiling) Reset()         { *m = QueryGasCeiling{} }
+func (m *QueryGasCeiling) String() string { return proto.CompactTextString(m) }
+func (*QueryGasCeiling) ProtoMessage()    {}
+func (*QueryGasCeiling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6571af6e35d5d95a, []int{4}
+}
+func (m *QueryGasCeiling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasCeiling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasCeiling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasCeiling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasCeiling.Merge(m, src)
+}
+func (m *QueryGasCeiling) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasCeiling) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasCeiling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasCeiling proto.InternalMessageInfo
+
+type QueryGasCeilingResponse struct {
+	GasCeiling uint64 `protobuf:"varint,1,opt,name=gas_ceiling,json=gasCeiling,proto3" json:"gas_ceiling,omitempty"`
+}
+
+func (m *QueryGasCeilingResponse) Reset()         { *m = QueryGasCeilingResponse{} }
+func (m *QueryGasCeilingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasCeilingResponse) ProtoMessage()    {}
+func (*QueryGasCeilingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6571af6e35d5d95a, []int{5}
+}
+func (m *QueryGasCeilingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasCeilingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasCeilingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasCeilingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasCeilingResponse.Merge(m, src)
+}
+func (m *QueryGasCeilingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasCeilingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasCeilingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasCeilingResponse proto.InternalMessageInfo
+
+func (m *QueryGasCeilingResponse) GetGasCeiling() uint64 {
+	if m != nil {
+		return m.GasCeiling
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryFeePolicies)(nil), "noble.feepolicy.v1.QueryFeePolicies")
+	proto.RegisterType((*QueryFeePoliciesResponse)(nil), "noble.feepolicy.v1.QueryFeePoliciesResponse")
+	proto.RegisterType((*QueryFeePolicy)(nil), "noble.feepolicy.v1.QueryFeePolicy")
+	proto.RegisterType((*QueryFeePolicyResponse)(nil), "noble.feepolicy.v1.QueryFeePolicyResponse")
+	proto.RegisterType((*QueryGasCeiling)(nil), "noble.feepolicy.v1.QueryGasCeiling")
+	proto.RegisterType((*QueryGasCeilingResponse)(nil), "noble.feepolicy.v1.QueryGasCeilingResponse")
+}
+
+func init() { proto.RegisterFile("noble/feepolicy/v1/query.proto", fileDescriptor_6571af6e35d5d95a) }
+
+var fileDescriptor_6571af6e35d5d95a = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0x13, 0x31,
+	0x18, 0xc6, 0x63, 0xca, 0xbf, 0xbc, 0x41, 0x40, 0x2d, 0x04, 0xe1, 0x00, 0x27, 0x18, 0x86, 0xaa,
+	0x69, 0xcf, 0x4a, 0xbb, 0x31, 0x16, 0x89, 0x0e, 0x08, 0x09, 0x22, 0xb1, 0xb0, 0x44, 0x4e, 0xe4,
+	0x18, 0x4b, 0x97, 0xf3, 0x11, 0x5f, 0x22, 0xbc, 0x32, 0x75, 0x60, 0xa8, 0xc4, 0x97, 0x60, 0x64,
+	0xe0, 0x43, 0x74, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0x82, 0xc4, 0xd7, 0x40, 0xe7, 0x24, 0xbe, 0xf4,
+	0x38, 0x68, 0xbb, 0x9c, 0x7c, 0x8f, 0x9f, 0xf7, 0x7d, 0x7e, 0x7e, 0x7d, 0x07, 0x24, 0xd6, 0xbd,
+	0x48, 0xb0, 0x81, 0x10, 0x89, 0x8e, 0x54, 0xdf, 0xb2, 0x49, 0x9b, 0xbd, 0x1b, 0x8b, 0x91, 0x0d,
+	0x93, 0x91, 0x4e, 0x35, 0xc6, 0x6e, 0x3f, 0xf4, 0xfb, 0xe1, 0xa4, 0x1d, 0xac, 0xf3, 0xa1, 0x8a,
+	0x35, 0x73, 0xcf, 0xb9, 0x2d, 0xb8, 0xd7, 0xd7, 0x66, 0xa8, 0xcd, 0xbc, 0xb4, 0xd0, 0x23, 0xb8,
+	0x25, 0xb5, 0xd4, 0x6e, 0xc9, 0xb2, 0xd5, 0x42, 0xbd, 0x2f, 0xb5, 0x96, 0x91, 0x60, 0x3c, 0x51,
+	0x8c, 0xc7, 0xb1, 0x4e, 0x79, 0xaa, 0x74, 0x6c, 0x16, 0xbb, 0xb4, 0x84, 0x2b, 0x87, 0x70, 0x1e,
+	0x8a, 0xe1, 0xe6, 0xab, 0x2c, 0xe6, 0x99, 0x10, 0x2f, 0x33, 0x5d, 0x09, 0x43, 0x25, 0xd4, 0x8b,
+	0x5a, 0x47, 0x98, 0x44, 0xc7, 0x46, 0xe0, 0xe7, 0x70, 0x6d, 0x20, 0x44, 0x37, 0x59, 0xe8, 0x75,
+	0xd4, 0x5c, 0xdb, 0xa8, 0xed, 0x3c, 0x08, 0xff, 0x3e, 0x62, 0xb8, 0x2c, 0xb7, 0x7b, 0xd5, 0xa3,
+	0x1f, 0x8d, 0xca, 0xe7, 0xdf, 0x5f, 0x36, 0x51, 0xa7, 0x36, 0x58, 0x09, 0x6a, 0xc1, 0xf5, 0x13,
+	0x41, 0x16, 0xdf, 0x85, 0xab, 0xa9, 0x4d, 0x44, 0x77, 0x3c, 0x8a, 0xea, 0xa8, 0x89, 0x36, 0xaa,
+	0x9d, 0x2b, 0xd9, 0xfb, 0xeb, 0x51, 0x44, 0x39, 0xdc, 0x3e, 0x69, 0xf6, 0x4c, 0xfb, 0x00, 0x9e,
+	0xc9, 0xba, 0xb2, 0xf3, 0x10, 0x55, 0x97, 0x44, 0x96, 0xae, 0xc3, 0x0d, 0x17, 0xb1, 0xcf, 0xcd,
+	0x53, 0xa1, 0x22, 0x15, 0x4b, 0xfa, 0x04, 0xee, 0x14, 0x24, 0x1f, 0xdb, 0x80, 0x9a, 0xe4, 0xa6,
+	0xdb, 0x9f, 0xcb, 0x2e, 0xf7, 0x62, 0x07, 0xa4, 0x37, 0xee, 0x7c, 0x5d, 0x83, 0x4b, 0xae, 0x18,
+	0x1f, 0x22, 0xa8, 0xad, 0x4c, 0x13, 0x3f, 0x2e, 0xa3, 0x2b, 0xce, 0x3c, 0xd8, 0x3a, 0x8b, 0x6b,
+	0x89, 0x43, 0xb7, 0x0f, 0xb2, 0xe3, 0x7c, 0xf8, 0xf6, 0xeb, 0xd3, 0x05, 0x8a, 0x9b, 0xac, 0xfc,
+	0xee, 0xfd, 0xc5, 0xe1, 0x03, 0x04, 0xd5, 0x7c, 0xee, 0xf4, 0xd4, 0x28, 0x1b, 0x6c, 0x9e, 0xee,
+	0xf1, 0x30, 0xad, 0x1c, 0xa6, 0x89, 0xc9, 0x7f, 0x61, 0x2c, 0xfe, 0x88, 0x00, 0xf2, 0xf9, 0xe2,
+	0x47, 0xff, 0xcc, 0xc9, 0x4d, 0x41, 0xeb, 0x0c, 0x26, 0x4f, 0xb3, 0x95, 0xd3, 0x3c, 0xc4, 0x8d,
+	0x32, 0x9a, 0x95, 0x8b, 0xdc, 0x7b, 0x71, 0x34, 0x25, 0xe8, 0x78, 0x4a, 0xd0, 0xcf, 0x29, 0x41,
+	0x87, 0x33, 0x52, 0x39, 0x9e, 0x91, 0xca, 0xf7, 0x19, 0xa9, 0xbc, 0xd9, 0x95, 0x2a, 0x7d, 0x3b,
+	0xee, 0x85, 0x7d, 0x3d, 0x9c, 0x37, 0xd9, 0xe6, 0xc6, 0x88, 0xd4, 0x2c, 0x3a, 0x4e, 0xda, 0x6d,
+	0xf6, 0x7e, 0xa5, 0x6f, 0xf6, 0xe5, 0x9a, 0xde, 0x65, 0xf7, 0xa3, 0xed, 0xfe, 0x19, 0x00, 0x18,
+	0xf5, 0xe8, 0xf3, 0x26, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	FeePolicies(ctx context.Context, in *QueryFeePolicies, opts ...grpc.CallOption) (*QueryFeePoliciesResponse, error)
+	FeePolicy(ctx context.Context, in *QueryFeePolicy, opts ...grpc.CallOption) (*QueryFeePolicyResponse, error)
+	GasCeiling(ctx context.Context, in *QueryGasCeiling, opts ...grpc.CallOption) (*QueryGasCeilingResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) FeePolicies(ctx context.Context, in *QueryFeePolicies, opts ...grpc.CallOption) (*QueryFeePoliciesResponse, error) {
+	out := new(QueryFeePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/noble.feepolicy.v1.Query/FeePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePolicy(ctx context.Context, in *QueryFeePolicy, opts ...grpc.CallOption) (*QueryFeePolicyResponse, error) {
+	out := new(QueryFeePolicyResponse)
+	err := c.cc.Invoke(ctx, "/noble.feepolicy.v1.Query/FeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasCeiling(ctx context.Context, in *QueryGasCeiling, opts ...grpc.CallOption) (*QueryGasCeilingResponse, error) {
+	out := new(QueryGasCeilingResponse)
+	err := c.cc.Invoke(ctx, "/noble.feepolicy.v1.Query/GasCeiling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	FeePolicies(context.Context, *QueryFeePolicies) (*QueryFeePoliciesResponse, error)
+	FeePolicy(context.Context, *QueryFeePolicy) (*QueryFeePolicyResponse, error)
+	GasCeiling(context.Context, *QueryGasCeiling) (*QueryGasCeilingResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) FeePolicies(ctx context.Context, req *QueryFeePolicies) (*QueryFeePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePolicies not implemented")
+}
+func (*UnimplementedQueryServer) FeePolicy(ctx context.Context, req *QueryFeePolicy) (*QueryFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePolicy not implemented")
+}
+func (*UnimplementedQueryServer) GasCeiling(ctx context.Context, req *QueryGasCeiling) (*QueryGasCeilingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasCeiling not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_FeePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.feepolicy.v1.Query/FeePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePolicies(ctx, req.(*QueryFeePolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.feepolicy.v1.Query/FeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePolicy(ctx, req.(*QueryFeePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasCeiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasCeiling)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasCeiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.feepolicy.v1.Query/GasCeiling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasCeiling(ctx, req.(*QueryGasCeiling))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.feepolicy.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FeePolicies",
+			Handler:    _Query_FeePolicies_Handler,
+		},
+		{
+			MethodName: "FeePolicy",
+			Handler:    _Query_FeePolicy_Handler,
+		},
+		{
+			MethodName: "GasCeiling",
+			Handler:    _Query_GasCeiling_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/feepolicy/v1/query.proto",
+}
+
+func (m *QueryFeePolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeePolicies) > 0 {
+		for iNdEx := len(m.FeePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasCeiling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasCeiling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasCeiling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasCeilingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasCeilingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasCeilingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasCeiling != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCeiling))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeePolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeePoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeePolicies) > 0 {
+		for _, e := range m.FeePolicies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasCeiling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasCeilingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasCeiling != 0 {
+		n += 1 + sovQuery(uint64(m.GasCeiling))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeePolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePolicies = append(m.FeePolicies, FeePolicy{})
+			if err := m.FeePolicies[len(m.FeePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasCeiling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasCeiling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasCeilingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasCeilingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasCeilingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCeiling", wireType)
+			}
+			m.GasCeiling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCeiling |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1298 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/feepolicy/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetFeePolicy is the request of the SetFeePolicy action.
+type MsgSetFeePolicy struct {
+	Signer    string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	FeePolicy FeePolicy `protobuf:"bytes,2,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy"`
+}
+
+func (m *MsgSetFeePolicy) Reset()         { *m = MsgSetFeePolicy{} }
+func (m *MsgSetFeePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeePolicy) ProtoMessage()    {}
+func (*MsgSetFeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a975bbee56200f6, []int{0}
+}
+func (m *MsgSetFeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeePolicy.Merge(m, src)
+}
+func (m *MsgSetFeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeePolicy proto.InternalMessageInfo
+
+// MsgSetFeePolicyResponse is the response of the SetFeePolicy action.
+type MsgSetFeePolicyResponse struct {
+}
+
+func (m *MsgSetFeePolicyResponse) Reset()         { *m = MsgSetFeePolicyResponse{} }
+func (m *MsgSetFeePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeePolicyResponse) ProtoMessage()    {}
+func (*MsgSetFeePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a975bbee56200f6, []int{1}
+}
+func (m *MsgSetFeePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeePolicyResponse.Merge(m, src)
+}
+func (m *MsgSetFeePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeePolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveFeePolicy is the request of the RemoveFeePolicy action.
+type MsgRemoveFeePolicy struct {
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	TypeUrl string `protobuf:"bytes,2,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+}
+
+func (m *MsgRemoveFeePolicy) Reset()         { *m = MsgRemoveFeePolicy{} }
+func (m *MsgRemoveFeePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeePolicy) ProtoMessage()    {}
+func (*MsgRemoveFeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a975bbee56200f6, []int{2}
+}
+func (m *MsgRemoveFeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeePolicy.Merge(m, src)
+}
+func (m *MsgRemoveFeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeePolicy proto.InternalMessageInfo
+
+// MsgRemoveFeePolicyResponse is the response of the RemoveFeePolicy action.
+type MsgRemoveFeePolicyResponse struct {
+}
+
+func (m *MsgRemoveFeePolicyResponse) Reset()         { *m = MsgRemoveFeePolicyResponse{} }
+func (m *MsgRemoveFeePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeePolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveFeePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a975bbee56200f6, []int{3}
+}
+func (m *MsgRemoveFeePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeePolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeePolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeePolicyResponse proto.InternalMessageInfo
+
+// MsgSetGasCeiling is the request of the SetGasCeiling action.
+type MsgSetGasCeiling struct {
+	Signer     string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	GasCeiling uint64 `protobuf:"varint,2,opt,name=gas_ceiling,json=gasCeiling,proto3" json:"gas_ceiling,omitempty"`
+}
+
+func (m *MsgSetGasCeiling) Reset()         { *m = MsgSetGasCeiling{} }
+func (m *MsgSetGasCeiling) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasCeiling) ProtoMessage()    {}
+func (*MsgSetGasCeiling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a975bbee56200f6, []int{4}
+}
+func (m *MsgSetGasCeiling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGasCeiling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGasCeiling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGasCeiling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasCeiling.Merge(m, src)
+}
+func (m *MsgSetGasCeiling) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGasCeiling) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasCeiling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGasCeiling proto.InternalMessageInfo
+
+// MsgSetGasCeilingResponse is the response of the SetGasCeiling action.
+type MsgSetGasCeilingResponse struct {
+}
+
+func (m *MsgSetGasCeilingResponse) Reset()         { *m = MsgSetGasCeilingResponse{} }
+func (m *MsgSetGasCeilingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasCeilingResponse) ProtoMessage()    {}
+func (*MsgSetGasCeilingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a975bbee56200f6, []int{5}
+}
+func (m *MsgSetGasCeilingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGasCeilingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGasCeilingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGasCeilingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasCeilingResponse.Merge(m, src)
+}
+func (m *MsgSetGasCeilingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGasCeilingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasCeilingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGasCeilingResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetFeePolicy)(nil), "noble.feepolicy.v1.MsgSetFeePolicy")
+	proto.RegisterType((*MsgSetFeePolicyResponse)(nil), "noble.feepolicy.v1.MsgSetFeePolicyResponse")
+	proto.RegisterType((*MsgRemoveFeePolicy)(nil), "noble.feepolicy.v1.MsgRemoveFeePolicy")
+	proto.RegisterType((*MsgRemoveFeePolicyResponse)(nil), "noble.feepolicy.v1.MsgRemoveFeePolicyResponse")
+	proto.RegisterType((*MsgSetGasCeiling)(nil), "noble.feepolicy.v1.MsgSetGasCeiling")
+	proto.RegisterType((*MsgSetGasCeilingResponse)(nil), "noble.feepolicy.v1.MsgSetGasCeilingResponse")
+}
+
+func init() { proto.RegisterFile("noble/feepolicy/v1/tx.proto", fileDescriptor_2a975bbee56200f6) }
+
+var fileDescriptor_2a975bbee56200f6 = []byte{
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x7d, 0x05, 0x0a, 0xb9, 0x82, 0x0a, 0x56, 0xa5, 0x26, 0xa6, 0xb5, 0x2b, 0x83, 0x50,
+	0x15, 0xa8, 0x8f, 0xb4, 0x20, 0xa4, 0x6c, 0x04, 0x89, 0x4e, 0x91, 0x90, 0x2b, 0x16, 0x96, 0x90,
+	0xb8, 0x2f, 0x87, 0x25, 0xdb, 0x17, 0xf9, 0xb9, 0x51, 0xbb, 0x21, 0x26, 0xc4, 0xc4, 0x8c, 0x18,
+	0x3a, 0x32, 0x66, 0x60, 0x66, 0x60, 0xea, 0x58, 0x31, 0x31, 0x21, 0x94, 0x0c, 0xe1, 0xcf, 0x40,
+	0x3e, 0x3b, 0x4e, 0xeb, 0xc6, 0x52, 0x04, 0x4b, 0x94, 0x77, 0xef, 0xbb, 0xf7, 0x7e, 0x9f, 0xdf,
+	0x3b, 0x7a, 0x3b, 0x10, 0x1d, 0x0f, 0x58, 0x17, 0xa0, 0x27, 0x3c, 0xd7, 0x39, 0x62, 0xfd, 0x1a,
+	0x8b, 0x0e, 0xad, 0x5e, 0x28, 0x22, 0xa1, 0xaa, 0x32, 0x69, 0x65, 0x49, 0xab, 0x5f, 0xd3, 0x6e,
+	0xb5, 0x7d, 0x37, 0x10, 0x4c, 0xfe, 0x26, 0x32, 0x6d, 0xd5, 0x11, 0xe8, 0x0b, 0x64, 0x3e, 0xf2,
+	0xf8, 0xba, 0x8f, 0x3c, 0x4d, 0x54, 0x92, 0x44, 0x4b, 0x46, 0x2c, 0x09, 0xd2, 0xd4, 0x0a, 0x17,
+	0x5c, 0x24, 0xe7, 0xf1, 0xbf, 0xf4, 0xd4, 0x9c, 0x41, 0x33, 0xed, 0x2e, 0x35, 0xe6, 0x77, 0x42,
+	0x97, 0x9b, 0xc8, 0xf7, 0x20, 0x7a, 0x0e, 0xf0, 0x42, 0x66, 0xd4, 0x87, 0x74, 0x11, 0x5d, 0x1e,
+	0x40, 0x58, 0x26, 0x1b, 0x64, 0xb3, 0xd4, 0x28, 0xff, 0xf8, 0xba, 0xb5, 0x92, 0xf6, 0x7b, 0xba,
+	0xbf, 0x1f, 0x02, 0xe2, 0x5e, 0x14, 0xba, 0x01, 0xb7, 0x53, 0x9d, 0xba, 0x4b, 0x69, 0x17, 0xa0,
+	0x95, 0x54, 0x2e, 0x2f, 0x6c, 0x90, 0xcd, 0xa5, 0xed, 0x75, 0xeb, 0xa2, 0x5f, 0x2b, 0x6b, 0xd2,
+	0x28, 0x9d, 0xfc, 0x32, 0x94, 0x2f, 0xe3, 0x41, 0x95, 0xd8, 0xa5, 0xee, 0xe4, 0xb4, 0xfe, 0xe8,
+	0xfd, 0xb1, 0xa1, 0xfc, 0x39, 0x36, 0x94, 0x77, 0xe3, 0x41, 0x35, 0xad, 0xfe, 0x61, 0x3c, 0xa8,
+	0xae, 0xe5, 0xad, 0x9c, 0x05, 0x36, 0x2b, 0x74, 0x35, 0xe7, 0xc1, 0x06, 0xec, 0x89, 0x00, 0xc1,
+	0xfc, 0x44, 0xa8, 0xda, 0x44, 0x6e, 0x83, 0x2f, 0xfa, 0xf0, 0x3f, 0x16, 0x2b, 0xf4, 0x5a, 0x74,
+	0xd4, 0x83, 0xd6, 0x41, 0xe8, 0x49, 0x83, 0x25, 0xfb, 0x6a, 0x1c, 0xbf, 0x0c, 0xbd, 0xfa, 0x93,
+	0x02, 0x68, 0x23, 0x0f, 0x9d, 0xa3, 0x30, 0xd7, 0xa8, 0x76, 0x91, 0x2d, 0x43, 0xff, 0x4c, 0xe8,
+	0xcd, 0xc4, 0xd6, 0x6e, 0x1b, 0x9f, 0x81, 0xeb, 0xb9, 0x01, 0xff, 0x07, 0x70, 0x83, 0x2e, 0xf1,
+	0x36, 0xb6, 0x9c, 0xa4, 0x80, 0x64, 0xbf, 0x6c, 0x53, 0x9e, 0x95, 0xac, 0x3f, 0x2e, 0xc0, 0x5f,
+	0x9f, 0xf1, 0xcd, 0xa7, 0x24, 0xa6, 0x46, 0xcb, 0x79, 0xba, 0x09, 0xfa, 0xf6, 0xb7, 0x05, 0x7a,
+	0xa9, 0x89, 0x5c, 0x7d, 0x4d, 0xaf, 0x9f, 0xdb, 0xac, 0x3b, 0xb3, 0x76, 0x22, 0x37, 0x3a, 0xed,
+	0xfe, 0x1c, 0xa2, 0x49, 0x27, 0xd5, 0xa5, 0xcb, 0xf9, 0xd9, 0xde, 0x2b, 0xb8, 0x9f, 0xd3, 0x69,
+	0xd6, 0x7c, 0xba, 0xac, 0x95, 0x43, 0x6f, 0x9c, 0x9f, 0xc5, 0xdd, 0x62, 0xd0, 0xa9, 0x4a, 0x7b,
+	0x30, 0x8f, 0x6a, 0xd2, 0x44, 0xbb, 0xf2, 0x36, 0x7e, 0x12, 0x8d, 0xe6, 0xc9, 0x50, 0x27, 0xa7,
+	0x43, 0x9d, 0xfc, 0x1e, 0xea, 0xe4, 0xe3, 0x48, 0x57, 0x4e, 0x47, 0xba, 0xf2, 0x73, 0xa4, 0x2b,
+	0xaf, 0x76, 0xb8, 0x1b, 0xbd, 0x39, 0xe8, 0x58, 0x8e, 0xf0, 0x99, 0x2c, 0xbc, 0xd5, 0x46, 0x84,
+	0x08, 0x93, 0x80, 0xf5, 0x6b, 0x35, 0x76, 0x78, 0x66, 0x66, 0xf1, 0x8e, 0x62, 0x67, 0x51, 0x3e,
+	0xf6, 0x9d, 0xbf, 0x03, 0x00, 0x42, 0x51, 0x20, 0x20, 0xa0, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetFeePolicy(ctx context.Context, in *MsgSetFeePolicy, opts ...grpc.CallOption) (*MsgSetFeePolicyResponse, error)
+	RemoveFeePolicy(ctx context.Context, in *MsgRemoveFeePolicy, opts ...grpc.CallOption) (*MsgRemoveFeePolicyResponse, error)
+	SetGasCeiling(ctx context.Context, in *MsgSetGasCeiling, opts ...grpc.CallOption) (*MsgSetGasCeilingResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetFeePolicy(ctx context.Context, in *MsgSetFeePolicy, opts ...grpc.CallOption) (*MsgSetFeePolicyResponse, error) {
+	out := new(MsgSetFeePolicyResponse)
+	err := c.cc.Invoke(ctx, "/noble.feepolicy.v1.Msg/SetFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeePolicy(ctx context.Context, in *MsgRemoveFeePolicy, opts ...grpc.CallOption) (*MsgRemoveFeePolicyResponse, error) {
+	out := new(MsgRemoveFeePolicyResponse)
+	err := c.cc.Invoke(ctx, "/noble.feepolicy.v1.Msg/RemoveFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetGasCeiling(ctx context.Context, in *MsgSetGasCeiling, opts ...grpc.CallOption) (*MsgSetGasCeilingResponse, error) {
+	out := new(MsgSetGasCeilingResponse)
+	err := c.cc.Invoke(ctx, "/noble.feepolicy.v1.Msg/SetGasCeiling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetFeePolicy(context.Context, *MsgSetFeePolicy) (*MsgSetFeePolicyResponse, error)
+	RemoveFeePolicy(context.Context, *MsgRemoveFeePolicy) (*MsgRemoveFeePolicyResponse, error)
+	SetGasCeiling(context.Context, *MsgSetGasCeiling) (*MsgSetGasCeilingResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetFeePolicy(ctx context.Context, req *MsgSetFeePolicy) (*MsgSetFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeePolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeePolicy(ctx context.Context, req *MsgRemoveFeePolicy) (*MsgRemoveFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeePolicy not implemented")
+}
+func (*UnimplementedMsgServer) SetGasCeiling(ctx context.Context, req *MsgSetGasCeiling) (*MsgSetGasCeilingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGasCeiling not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.feepolicy.v1.Msg/SetFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeePolicy(ctx, req.(*MsgSetFeePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.feepolicy.v1.Msg/RemoveFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeePolicy(ctx, req.(*MsgRemoveFeePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGasCeiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGasCeiling)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGasCeiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.feepolicy.v1.Msg/SetGasCeiling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGasCeiling(ctx, req.(*MsgSetGasCeiling))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.feepolicy.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetFeePolicy",
+			Handler:    _Msg_SetFeePolicy_Handler,
+		},
+		{
+			MethodName: "RemoveFeePolicy",
+			Handler:    _Msg_RemoveFeePolicy_Handler,
+		},
+		{
+			MethodName: "SetGasCeiling",
+			Handler:    _Msg_SetGasCeiling_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/feepolicy/v1/tx.proto",
+}
+
+func (m *MsgSetFeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGasCeiling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGasCeiling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasCeiling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasCeiling != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasCeiling))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGasCeilingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGasCeilingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasCeilingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetFeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FeePolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFeePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetGasCeiling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasCeiling != 0 {
+		n += 1 + sovTx(uint64(m.GasCeiling))
+	}
+	return n
+}
+
+func (m *MsgSetGasCeilingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetFeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeePolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeePolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGasCeiling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGasCeiling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGasCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCeiling", wireType)
+			}
+			m.GasCeiling = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCeiling |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGasCeilingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGasCeilingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGasCeilingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package injection
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v11/x/injection/keeper"
+	"github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+func InitGenesis(ctx context.Context, k *keeper.Keeper, genesis types.GenesisState) {
+	if err := k.Params.Set(ctx, genesis.Params); err != nil {
+		panic(err)
+	}
+
+	for _, delivery := range genesis.FailedDeliveries {
+		if err := k.FailedDeliveries.Set(ctx, delivery.Digest, delivery); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.FailedDeliveryCount.Set(ctx, uint64(len(genesis.FailedDeliveries))); err != nil {
+		panic(err)
+	}
+}
+
+func ExportGenesis(ctx context.Context, k *keeper.Keeper) *types.GenesisState {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	deliveries, err := k.GetFailedDeliveries(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params:           params,
+		FailedDeliveries: deliveries,
+	}
+}
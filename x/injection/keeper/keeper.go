@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+type Keeper struct {
+	authority    string
+	cdc          codec.Codec
+	eventService event.Service
+
+	Schema              collections.Schema
+	Params              collections.Item[types.Params]
+	FailedDeliveries    *collections.IndexedMap[string, types.FailedDelivery, FailedDeliveryIndexes]
+	FailedDeliveryCount collections.Item[uint64]
+}
+
+func NewKeeper(
+	authority string,
+	cdc codec.Codec,
+	storeService store.KVStoreService,
+	eventService event.Service,
+) *Keeper {
+	builder := collections.NewSchemaBuilder(storeService)
+
+	keeper := &Keeper{
+		authority:    authority,
+		cdc:          cdc,
+		eventService: eventService,
+
+		Params:              collections.NewItem(builder, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FailedDeliveries:    collections.NewIndexedMap(builder, types.FailedDeliveryPrefix, "failed_deliveries", collections.StringKey, codec.CollValue[types.FailedDelivery](cdc), NewFailedDeliveryIndexes(builder)),
+		FailedDeliveryCount: collections.NewItem(builder, types.FailedDeliveryCountKey, "failed_delivery_count", collections.Uint64Value),
+	}
+
+	schema, err := builder.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	keeper.Schema = schema
+	return keeper
+}
+
+// FailedDeliveryIndexes defines the indexes of failed deliveries, keyed by
+// the digest of their VAA.
+type FailedDeliveryIndexes struct {
+	Sender    *indexes.Multi[string, string, types.FailedDelivery]
+	Recipient *indexes.Multi[string, string, types.FailedDelivery]
+	Height    *indexes.Multi[int64, string, types.FailedDelivery]
+}
+
+func NewFailedDeliveryIndexes(builder *collections.SchemaBuilder) FailedDeliveryIndexes {
+	return FailedDeliveryIndexes{
+		Sender: indexes.NewMulti(
+			builder, types.SenderIndexPrefix, "failed_deliveries_by_sender",
+			collections.StringKey, collections.StringKey,
+			func(_ string, delivery types.FailedDelivery) (string, error) {
+				return delivery.Sender, nil
+			},
+		),
+		Recipient: indexes.NewMulti(
+			builder, types.RecipientIndexPrefix, "failed_deliveries_by_recipient",
+			collections.StringKey, collections.StringKey,
+			func(_ string, delivery types.FailedDelivery) (string, error) {
+				return delivery.Recipient, nil
+			},
+		),
+		Height: indexes.NewMulti(
+			builder, types.HeightIndexPrefix, "failed_deliveries_by_height",
+			collections.Int64Key, collections.StringKey,
+			func(_ string, delivery types.FailedDelivery) (int64, error) {
+				return delivery.Height, nil
+			},
+		),
+	}
+}
+
+func (i FailedDeliveryIndexes) IndexesList() []collections.Index[string, types.FailedDelivery] {
+	return []collections.Index[string, types.FailedDelivery]{i.Sender, i.Recipient, i.Height}
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v11/testutil"
+	"github.com/noble-assets/noble/v11/x/injection/keeper"
+	"github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+// setupKeeper is a test utility that returns an Injection keeper with the
+// given params backed by an in-memory store, alongside a context for that
+// store.
+func setupKeeper(t *testing.T, params types.Params) (*keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	k, ctx := testutil.SetupKeeper(t, types.ModuleName, keeper.NewKeeper)
+	require.NoError(t, k.Params.Set(ctx, params))
+	require.NoError(t, k.FailedDeliveryCount.Set(ctx, 0))
+
+	return k, ctx
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	"github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+func NewMsgServer(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) SetParams(ctx context.Context, msg *types.MsgSetParams) (*types.MsgSetParamsResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, errors.Wrap(err, "failed to set params in state")
+	}
+
+	return &types.MsgSetParamsResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ParamsUpdated{
+		Params: msg.Params,
+	})
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+var _ types.QueryServer = &queryServer{}
+
+type queryServer struct {
+	*Keeper
+}
+
+func NewQueryServer(keeper *Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+func (k queryServer) Params(ctx context.Context, req *types.QueryParams) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	params, err := k.Keeper.Params.Get(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, err
+}
+
+func (k queryServer) FailedDeliveries(ctx context.Context, req *types.QueryFailedDeliveries) (*types.QueryFailedDeliveriesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	deliveries, pagination, err := query.CollectionPaginate(
+		ctx, k.Keeper.FailedDeliveries, req.Pagination,
+		func(_ string, delivery types.FailedDelivery) (types.FailedDelivery, error) {
+			return delivery, nil
+		},
+	)
+
+	return &types.QueryFailedDeliveriesResponse{
+		FailedDeliveries: deliveries,
+		Pagination:       pagination,
+	}, err
+}
+
+func (k queryServer) FailedDelivery(ctx context.Context, req *types.QueryFailedDelivery) (*types.QueryFailedDeliveryResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	delivery, err := k.Keeper.FailedDeliveries.Get(ctx, req.Digest)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrFailedDeliveryNotFound
+		}
+
+		return nil, err
+	}
+
+	return &types.QueryFailedDeliveryResponse{FailedDelivery: delivery}, nil
+}
+
+func (k queryServer) FailedDeliveriesBySender(ctx context.Context, req *types.QueryFailedDeliveriesBySender) (*types.QueryFailedDeliveriesResponse, error) {
+	if req == nil || req.Sender == "" {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	deliveries, pagination, err := query.CollectionPaginate(
+		ctx, k.Keeper.FailedDeliveries.Indexes.Sender, req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.FailedDelivery, error) {
+			return k.Keeper.FailedDeliveries.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](types.NormalizeSender(req.Sender)),
+	)
+
+	return &types.QueryFailedDeliveriesResponse{
+		FailedDeliveries: deliveries,
+		Pagination:       pagination,
+	}, err
+}
+
+func (k queryServer) FailedDeliveriesByRecipient(ctx context.Context, req *types.QueryFailedDeliveriesByRecipient) (*types.QueryFailedDeliveriesResponse, error) {
+	if req == nil || req.Recipient == "" {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	deliveries, pagination, err := query.CollectionPaginate(
+		ctx, k.Keeper.FailedDeliveries.Indexes.Recipient, req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.FailedDelivery, error) {
+			return k.Keeper.FailedDeliveries.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Recipient),
+	)
+
+	return &types.QueryFailedDeliveriesResponse{
+		FailedDeliveries: deliveries,
+		Pagination:       pagination,
+	}, err
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+const (
+	// RemovalReasonDelivered is the reason of removing a failed delivery once
+	// the transfer was successfully delivered.
+	RemovalReasonDelivered = "delivered"
+	// RemovalReasonExpired is the reason of removing a failed delivery once it
+	// exceeded the retention period.
+	RemovalReasonExpired = "expired"
+	// RemovalReasonEvicted is the reason of removing the oldest failed delivery
+	// once the maximum number of failed deliveries is reached.
+	RemovalReasonEvicted = "evicted"
+)
+
+// GetFailedDeliveries is a utility that returns all failed deliveries from state.
+func (k *Keeper) GetFailedDeliveries(ctx context.Context) ([]types.FailedDelivery, error) {
+	var deliveries []types.FailedDelivery
+
+	err := k.FailedDeliveries.Walk(ctx, nil, func(_ string, delivery types.FailedDelivery) (stop bool, err error) {
+		deliveries = append(deliveries, delivery)
+		return false, nil
+	})
+
+	return deliveries, err
+}
+
+// RecordFailedDelivery records a failed delivery of an injected transfer at
+// the current height. If the transfer already failed before, its number of
+// attempts is incremented. Once the maximum number of failed deliveries is
+// reached, the oldest failed deliveries are evicted.
+func (k *Keeper) RecordFailedDelivery(ctx context.Context, delivery types.FailedDelivery) error {
+	delivery.Height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	delivery.Attempts = 1
+
+	existing, err := k.FailedDeliveries.Get(ctx, delivery.Digest)
+	switch {
+	case err == nil:
+		delivery.Attempts = existing.Attempts + 1
+	case errors.Is(err, collections.ErrNotFound):
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		count, err := k.FailedDeliveryCount.Get(ctx)
+		if err != nil {
+			return err
+		}
+		for ; count >= params.MaxFailedDeliveries; count-- {
+			if err := k.evictOldestFailedDelivery(ctx); err != nil {
+				return err
+			}
+		}
+
+		if err := k.FailedDeliveryCount.Set(ctx, count+1); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	return k.FailedDeliveries.Set(ctx, delivery.Digest, delivery)
+}
+
+// RemoveFailedDelivery removes the failed delivery of a transfer, if any.
+func (k *Keeper) RemoveFailedDelivery(ctx context.Context, digest string, reason string) error {
+	has, err := k.FailedDeliveries.Has(ctx, digest)
+	if err != nil || !has {
+		return err
+	}
+
+	if err := k.FailedDeliveries.Remove(ctx, digest); err != nil {
+		return err
+	}
+
+	count, err := k.FailedDeliveryCount.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.FailedDeliveryCount.Set(ctx, count-1); err != nil {
+		return err
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.FailedDeliveryRemoved{
+		Digest: digest,
+		Reason: reason,
+	})
+}
+
+// PruneFailedDeliveries removes all failed deliveries whose most recent
+// attempt exceeds the retention period.
+func (k *Keeper) PruneFailedDeliveries(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	cutoff := sdk.UnwrapSDKContext(ctx).BlockHeight() - int64(params.RetentionBlocks)
+	if cutoff <= 0 {
+		return nil
+	}
+
+	var digests []string
+	err = k.FailedDeliveries.Indexes.Height.Walk(ctx, nil, func(height int64, digest string) (stop bool, err error) {
+		if height > cutoff {
+			return true, nil
+		}
+
+		digests = append(digests, digest)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, digest := range digests {
+		if err := k.RemoveFailedDelivery(ctx, digest, RemovalReasonExpired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// evictOldestFailedDelivery is an internal helper that removes the failed
+// delivery with the oldest most recent attempt.
+func (k *Keeper) evictOldestFailedDelivery(ctx context.Context) error {
+	var oldest string
+	err := k.FailedDeliveries.Indexes.Height.Walk(ctx, nil, func(_ int64, digest string) (stop bool, err error) {
+		oldest = digest
+		return true, nil
+	})
+	if err != nil || oldest == "" {
+		return err
+	}
+
+	return k.RemoveFailedDelivery(ctx, oldest, RemovalReasonEvicted)
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v11/x/injection/keeper"
+	"github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+func TestRecordFailedDelivery(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxFailedDeliveries = 2
+	k, ctx := setupKeeper(t, params)
+
+	// ARRANGE: Record two failed deliveries at increasing heights.
+	require.NoError(t, k.RecordFailedDelivery(ctx.WithBlockHeight(1), types.FailedDelivery{Digest: "a"}))
+	require.NoError(t, k.RecordFailedDelivery(ctx.WithBlockHeight(2), types.FailedDelivery{Digest: "b"}))
+
+	// ACT: Record a repeated failed delivery of the oldest transfer.
+	require.NoError(t, k.RecordFailedDelivery(ctx.WithBlockHeight(3), types.FailedDelivery{Digest: "a"}))
+
+	// ASSERT: Its attempts and height are updated, without evicting anything.
+	delivery, err := k.FailedDeliveries.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), delivery.Attempts)
+	require.Equal(t, int64(3), delivery.Height)
+	requireFailedDeliveries(t, k, ctx, 2, "a", "b")
+
+	// ACT: Record a new failed delivery once the maximum is reached.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RecordFailedDelivery(ctx.WithBlockHeight(4), types.FailedDelivery{Digest: "c"}))
+
+	// ASSERT: The failed delivery with the oldest most recent attempt is
+	// evicted.
+	requireFailedDeliveries(t, k, ctx, 2, "a", "c")
+	requireRemoved(t, ctx, keeper.RemovalReasonEvicted, "b")
+
+	// ARRANGE: Lower the maximum below the number of failed deliveries.
+	params.MaxFailedDeliveries = 1
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	// ACT: Record a new failed delivery.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RecordFailedDelivery(ctx.WithBlockHeight(5), types.FailedDelivery{Digest: "d"}))
+
+	// ASSERT: All failed deliveries exceeding the maximum are evicted.
+	requireFailedDeliveries(t, k, ctx, 1, "d")
+	requireRemoved(t, ctx, keeper.RemovalReasonEvicted, "a", "c")
+}
+
+func TestPruneFailedDeliveries(t *testing.T) {
+	params := types.DefaultParams()
+	params.RetentionBlocks = 10
+	k, ctx := setupKeeper(t, params)
+
+	require.NoError(t, k.RecordFailedDelivery(ctx.WithBlockHeight(1), types.FailedDelivery{Digest: "a"}))
+	require.NoError(t, k.RecordFailedDelivery(ctx.WithBlockHeight(5), types.FailedDelivery{Digest: "b"}))
+	require.NoError(t, k.RecordFailedDelivery(ctx.WithBlockHeight(10), types.FailedDelivery{Digest: "c"}))
+
+	// ACT: Prune within the retention period of all failed deliveries.
+	require.NoError(t, k.PruneFailedDeliveries(ctx.WithBlockHeight(10)))
+
+	// ASSERT: Nothing is removed.
+	requireFailedDeliveries(t, k, ctx, 3, "a", "b", "c")
+
+	// ACT: Prune once the first two failed deliveries exceed the retention
+	// period.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.PruneFailedDeliveries(ctx.WithBlockHeight(15)))
+
+	// ASSERT: The expired failed deliveries are removed.
+	requireFailedDeliveries(t, k, ctx, 1, "c")
+	requireRemoved(t, ctx, keeper.RemovalReasonExpired, "a", "b")
+}
+
+// requireFailedDeliveries is a test utility that asserts the digests of all
+// failed deliveries in state, and their count.
+func requireFailedDeliveries(t *testing.T, k *keeper.Keeper, ctx sdk.Context, count uint64, digests ...string) {
+	t.Helper()
+
+	deliveries, err := k.GetFailedDeliveries(ctx)
+	require.NoError(t, err)
+
+	var actual []string
+	for _, delivery := range deliveries {
+		actual = append(actual, delivery.Digest)
+	}
+	require.Equal(t, digests, actual)
+
+	actualCount, err := k.FailedDeliveryCount.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, count, actualCount)
+}
+
+// requireRemoved is a test utility that asserts the removal events emitted
+// in a context.
+func requireRemoved(t *testing.T, ctx sdk.Context, reason string, digests ...string) {
+	t.Helper()
+
+	var actual []string
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+
+		removed, ok := msg.(*types.FailedDeliveryRemoved)
+		require.True(t, ok)
+		require.Equal(t, reason, removed.Reason)
+		actual = append(actual, removed.Digest)
+	}
+	require.Equal(t, digests, actual)
+}
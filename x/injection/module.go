@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package injection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	modulev1 "github.com/noble-assets/noble/v11/api/injection/module/v1"
+	"github.com/noble-assets/noble/v11/x/injection/keeper"
+	"github.com/noble-assets/noble/v11/x/injection/types"
+)
+
+const ConsensusVersion = 1
+
+var (
+	_ module.AppModuleBasic      = AppModule{}
+	_ appmodule.AppModule        = AppModule{}
+	_ module.HasConsensusVersion = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.HasServices         = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
+)
+
+//
+
+type AppModuleBasic struct{}
+
+func NewAppModuleBasic() AppModuleBasic {
+	return AppModuleBasic{}
+}
+
+func (AppModuleBasic) Name() string { return types.ModuleName }
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+func (AppModuleBasic) RegisterInterfaces(reg codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
+}
+
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genesis types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return genesis.Validate()
+}
+
+//
+
+type AppModule struct {
+	AppModuleBasic
+
+	keeper *keeper.Keeper
+}
+
+func NewAppModule(keeper *keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: NewAppModuleBasic(),
+		keeper:         keeper,
+	}
+}
+
+func (AppModule) IsOnePerModuleType() {}
+
+func (AppModule) IsAppModule() {}
+
+func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
+
+func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) {
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &genesis)
+
+	InitGenesis(ctx, m.keeper, genesis)
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genesis := ExportGenesis(ctx, m.keeper)
+	return cdc.MustMarshalJSON(genesis)
+}
+
+func (m AppModule) BeginBlock(ctx context.Context) error {
+	return m.keeper.PruneFailedDeliveries(ctx)
+}
+
+func (m AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(m.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(m.keeper))
+}
+
+//
+
+func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.MsgServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SetParams",
+					Use:            "set-params [params]",
+					Short:          "Set how failed deliveries of injected transfers are retained",
+					Example:        `set-params '{"max_failed_deliveries":"10000","retention_blocks":"1000000"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+				},
+			},
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.QueryServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query how failed deliveries of injected transfers are retained",
+				},
+				{
+					RpcMethod: "FailedDeliveries",
+					Use:       "failed-deliveries",
+					Short:     "Query all failed deliveries of injected transfers",
+				},
+				{
+					RpcMethod:      "FailedDelivery",
+					Use:            "failed-delivery [digest]",
+					Short:          "Query the failed delivery of an injected transfer by its VAA digest",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "digest"}},
+				},
+				{
+					RpcMethod:      "FailedDeliveriesBySender",
+					Use:            "failed-deliveries-by-sender [sender]",
+					Short:          "Query all failed deliveries of injected transfers from a sender on the source chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+				},
+				{
+					RpcMethod:      "FailedDeliveriesByRecipient",
+					Use:            "failed-deliveries-by-recipient [recipient]",
+					Short:          "Query all failed deliveries of injected transfers to a recipient on Noble",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+			},
+		},
+	}
+}
+
+//
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
+	EventService event.Service
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	Keeper *keeper.Keeper
+	Module appmodule.AppModule
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	if in.Config.Authority == "" {
+		panic("authority for Injection module must be set")
+	}
+
+	authority := authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	k := keeper.NewKeeper(
+		authority.String(),
+		in.Cdc,
+		in.StoreService,
+		in.EventService,
+	)
+	m := NewAppModule(k)
+
+	return ModuleOutputs{Keeper: k, Module: m}
+}
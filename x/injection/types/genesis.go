@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+)
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func (genesis *GenesisState) Validate() error {
+	if err := genesis.Params.Validate(); err != nil {
+		return errors.Wrap(err, "failed to validate params")
+	}
+
+	if uint64(len(genesis.FailedDeliveries)) > genesis.Params.MaxFailedDeliveries {
+		return fmt.Errorf("%d failed deliveries exceed maximum of %d", len(genesis.FailedDeliveries), genesis.Params.MaxFailedDeliveries)
+	}
+
+	seen := make(map[string]bool)
+	for _, delivery := range genesis.FailedDeliveries {
+		if seen[delivery.Digest] {
+			return fmt.Errorf("duplicate failed delivery %s", delivery.Digest)
+		}
+		seen[delivery.Digest] = true
+
+		if err := delivery.Validate(); err != nil {
+			return errors.Wrap(err, "failed to validate failed delivery")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// MarkInjectedTx marks the tx being built as injected by the given injector,
+// alongside optional metadata required to validate the tx.
+func MarkInjectedTx(builder client.TxBuilder, injector string, metadata []byte) error {
+	extensionBuilder, ok := builder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return fmt.Errorf("tx builder does not support extension options")
+	}
+
+	marker, err := codectypes.NewAnyWithValue(&InjectedTx{Injector: injector, Metadata: metadata})
+	if err != nil {
+		return err
+	}
+	extensionBuilder.SetExtensionOptions(marker)
+
+	return nil
+}
+
+// GetInjectedTx returns the marker of an injected tx, if the tx is marked.
+func GetInjectedTx(tx sdk.Tx) (*InjectedTx, bool) {
+	extensionTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, false
+	}
+
+	for _, option := range extensionTx.GetExtensionOptions() {
+		if marker, ok := option.GetCachedValue().(*InjectedTx); ok {
+			return marker, true
+		}
+	}
+
+	return nil, false
+}
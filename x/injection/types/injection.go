@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+const (
+	// DefaultMaxFailedDeliveries is the default maximum number of failed
+	// deliveries kept in state.
+	DefaultMaxFailedDeliveries = 10_000
+	// DefaultRetentionBlocks is the default number of blocks a failed delivery
+	// is kept in state, roughly two weeks.
+	DefaultRetentionBlocks = 1_000_000
+	// DefaultMaxVoteExtensionVAAs is the default maximum number of transfers
+	// attested in a single vote extension.
+	DefaultMaxVoteExtensionVAAs = 100
+	// DefaultMaxVoteExtensionBytes is the default maximum size of a single
+	// vote extension, 4 KiB, enough for the digests of 100 transfers.
+	DefaultMaxVoteExtensionBytes = 4 << 10
+	// DefaultMaxVAAsPerBlock is the default maximum number of transfers
+	// injected in a single block.
+	DefaultMaxVAAsPerBlock = 100
+	// DefaultGasPerVAA is the default amount of gas charged per injected
+	// transfer.
+	DefaultGasPerVAA = 200_000
+	// DefaultMaxInjectedTxBytes is the default maximum size of a single
+	// injected tx, 1 MiB.
+	DefaultMaxInjectedTxBytes = 1 << 20
+)
+
+// DefaultAttestationThreshold is the default share of the total voting power
+// that must attest to a transfer for it to be injected, i.e. two thirds.
+var DefaultAttestationThreshold = math.LegacyNewDec(2).QuoInt64(3)
+
+func DefaultParams() Params {
+	return Params{
+		MaxFailedDeliveries:   DefaultMaxFailedDeliveries,
+		RetentionBlocks:       DefaultRetentionBlocks,
+		AttestationThreshold:  DefaultAttestationThreshold,
+		MaxVoteExtensionVaas:  DefaultMaxVoteExtensionVAAs,
+		MaxVoteExtensionBytes: DefaultMaxVoteExtensionBytes,
+		MaxVaasPerBlock:       DefaultMaxVAAsPerBlock,
+		GasPerVaa:             DefaultGasPerVAA,
+		MaxInjectedTxBytes:    DefaultMaxInjectedTxBytes,
+	}
+}
+
+func (params Params) Validate() error {
+	if params.MaxFailedDeliveries == 0 {
+		return errors.New("max failed deliveries must be positive")
+	}
+	if params.RetentionBlocks == 0 {
+		return errors.New("retention blocks must be positive")
+	}
+	if params.AttestationThreshold.IsNil() || params.AttestationThreshold.IsNegative() || params.AttestationThreshold.GTE(math.LegacyOneDec()) {
+		return errors.New("attestation threshold must be in the range [0, 1)")
+	}
+	if params.MaxVoteExtensionVaas == 0 {
+		return errors.New("max vote extension vaas must be positive")
+	}
+	if params.MaxVoteExtensionBytes == 0 {
+		return errors.New("max vote extension bytes must be positive")
+	}
+	if params.MaxVaasPerBlock == 0 {
+		return errors.New("max vaas per block must be positive")
+	}
+	if params.GasPerVaa == 0 {
+		return errors.New("gas per vaa must be positive")
+	}
+	if params.MaxInjectedTxBytes == 0 || params.MaxInjectedTxBytes > cmttypes.MaxBlockSizeBytes {
+		return fmt.Errorf("max injected tx bytes must be in the range [1, %d]", cmttypes.MaxBlockSizeBytes)
+	}
+
+	return nil
+}
+
+func (delivery FailedDelivery) Validate() error {
+	if delivery.Digest == "" {
+		return errors.New("digest must not be empty")
+	}
+	if delivery.Attempts == 0 {
+		return fmt.Errorf("failed delivery %s must have at least one attempt", delivery.Digest)
+	}
+
+	return nil
+}
+
+// NormalizeSender returns the universal address format in which senders are
+// stored, i.e. a lowercase, 0x prefixed, hex encoded 32 byte address. Shorter
+// addresses, such as 20 byte EVM addresses, are left padded with zeros.
+func NormalizeSender(sender string) string {
+	sender = strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(sender, "0x"), "0X"))
+	if len(sender) < 64 {
+		sender = strings.Repeat("0", 64-len(sender)) + sender
+	}
+
+	return "0x" + sender
+}
@@ -0,0 +1,1379 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/injection/v1/injection.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InjectedTx is the extension option that marks a tx injected into a block
+// proposal by the proposer. Injected txs are only processed in the PreBlocker,
+// and are skipped when delivering all other txs of the block.
+type InjectedTx struct {
+	// injector is the name of the injector that injected the tx.
+	Injector string `protobuf:"bytes,1,opt,name=injector,proto3" json:"injector,omitempty"`
+	// metadata is optional data the injector requires to validate the tx,
+	// e.g. the vote extensions the injected data was taken from.
+	Metadata []byte `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *InjectedTx) Reset()         { *m = InjectedTx{} }
+func (m *InjectedTx) String() string { return proto.CompactTextString(m) }
+func (*InjectedTx) ProtoMessage()    {}
+func (*InjectedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33fb1f65c6d9df97, []int{0}
+}
+func (m *InjectedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InjectedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InjectedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InjectedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InjectedTx.Merge(m, src)
+}
+func (m *InjectedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *InjectedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_InjectedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InjectedTx proto.InternalMessageInfo
+
+func (m *InjectedTx) GetInjector() string {
+	if m != nil {
+		return m.Injector
+	}
+	return ""
+}
+
+func (m *InjectedTx) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// FailedDelivery is the record of a transfer injected into a block proposal,
+// whose delivery failed.
+type FailedDelivery struct {
+	// digest is the signing digest of the transfer's VAA.
+	Digest string `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	// message_id is the Wormhole message ID of the transfer's VAA.
+	MessageId string `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// emitter_chain is the Wormhole chain ID the transfer originated from.
+	EmitterChain uint32 `protobuf:"varint,3,opt,name=emitter_chain,json=emitterChain,proto3" json:"emitter_chain,omitempty"`
+	// sender is the hex encoded universal address of the transfer's sender on
+	// the source chain. Empty if the transfer couldn't be parsed.
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the address of the transfer's recipient on Noble. Empty if
+	// the transfer couldn't be parsed.
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the trimmed amount of the transfer. Empty if the transfer
+	// couldn't be parsed.
+	Amount string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// height is the block height of the most recent failed delivery.
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// error is the reason of the most recent failed delivery.
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// attempts is the number of failed deliveries.
+	Attempts uint64 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *FailedDelivery) Reset()         { *m = FailedDelivery{} }
+func (m *FailedDelivery) String() string { return proto.CompactTextString(m) }
+func (*FailedDelivery) ProtoMessage()    {}
+func (*FailedDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33fb1f65c6d9df97, []int{1}
+}
+func (m *FailedDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedDelivery.Merge(m, src)
+}
+func (m *FailedDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedDelivery proto.InternalMessageInfo
+
+func (m *FailedDelivery) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *FailedDelivery) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *FailedDelivery) GetEmitterChain() uint32 {
+	if m != nil {
+		return m.EmitterChain
+	}
+	return 0
+}
+
+func (m *FailedDelivery) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *FailedDelivery) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *FailedDelivery) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *FailedDelivery) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FailedDelivery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedDelivery) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+// Params defines how transfers are attested in vote extensions, and how
+// failed deliveries are retained.
+type Params struct {
+	// max_failed_deliveries is the maximum number of failed deliveries kept in
+	// state. Once reached, the oldest failed delivery is removed.
+	MaxFailedDeliveries uint64 `protobuf:"varint,1,opt,name=max_failed_deliveries,json=maxFailedDeliveries,proto3" json:"max_failed_deliveries,omitempty"`
+	// retention_blocks is the number of blocks a failed delivery is kept in
+	// state after its most recent attempt.
+	RetentionBlocks uint64 `protobuf:"varint,2,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty"`
+	// attestation_threshold is the share of the total voting power that must
+	// attest to a transfer in its vote extension for it to be injected, once
+	// vote extensions are enabled via the consensus params.
+	AttestationThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=attestation_threshold,json=attestationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"attestation_threshold"`
+	// max_vote_extension_vaas is the maximum number of transfers attested in a
+	// single vote extension.
+	MaxVoteExtensionVaas uint64 `protobuf:"varint,4,opt,name=max_vote_extension_vaas,json=maxVoteExtensionVaas,proto3" json:"max_vote_extension_vaas,omitempty"`
+	// max_vote_extension_bytes is the maximum size of a single vote extension.
+	MaxVoteExtensionBytes uint64 `protobuf:"varint,5,opt,name=max_vote_extension_bytes,json=maxVoteExtensionBytes,proto3" json:"max_vote_extension_bytes,omitempty"`
+	// max_vaas_per_block is the maximum number of transfers injected in a
+	// single block.
+	MaxVaasPerBlock uint64 `protobuf:"varint,6,opt,name=max_vaas_per_block,json=maxVaasPerBlock,proto3" json:"max_vaas_per_block,omitempty"`
+	// gas_per_vaa is the amount of gas charged per injected transfer.
+	GasPerVaa uint64 `protobuf:"varint,7,opt,name=gas_per_vaa,json=gasPerVaa,proto3" json:"gas_per_vaa,omitempty"`
+	// max_injected_tx_bytes is the maximum size of a single injected tx.
+	MaxInjectedTxBytes uint64 `protobuf:"varint,8,opt,name=max_injected_tx_bytes,json=maxInjectedTxBytes,proto3" json:"max_injected_tx_bytes,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33fb1f65c6d9df97, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxFailedDeliveries() uint64 {
+	if m != nil {
+		return m.MaxFailedDeliveries
+	}
+	return 0
+}
+
+func (m *Params) GetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVoteExtensionVaas() uint64 {
+	if m != nil {
+		return m.MaxVoteExtensionVaas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVoteExtensionBytes() uint64 {
+	if m != nil {
+		return m.MaxVoteExtensionBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVaasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxVaasPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetGasPerVaa() uint64 {
+	if m != nil {
+		return m.GasPerVaa
+	}
+	return 0
+}
+
+func (m *Params) GetMaxInjectedTxBytes() uint64 {
+	if m != nil {
+		return m.MaxInjectedTxBytes
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InjectedTx)(nil), "noble.injection.v1.InjectedTx")
+	proto.RegisterType((*FailedDelivery)(nil), "noble.injection.v1.FailedDelivery")
+	proto.RegisterType((*Params)(nil), "noble.injection.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("noble/injection/v1/injection.proto", fileDescriptor_33fb1f65c6d9df97)
+}
+
+var fileDescriptor_33fb1f65c6d9df97 = []byte{
+	// 626 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
+	0x14, 0x8c, 0x5b, 0x37, 0x5f, 0xb3, 0x5f, 0x4b, 0xd1, 0x92, 0x82, 0x29, 0x90, 0x46, 0x81, 0x43,
+	0x10, 0xaa, 0x2d, 0x53, 0x21, 0x24, 0x8e, 0xa1, 0x20, 0x55, 0x02, 0x51, 0x59, 0x55, 0x0f, 0x5c,
+	0xac, 0x17, 0xfb, 0xd5, 0x5e, 0x1a, 0x7b, 0xa3, 0xdd, 0xad, 0xe5, 0xdc, 0xf9, 0x01, 0xfc, 0x98,
+	0xde, 0xb9, 0x56, 0x9c, 0x2a, 0x4e, 0x88, 0x43, 0x85, 0xda, 0x3f, 0x82, 0x76, 0xd7, 0x4d, 0x0b,
+	0xe2, 0x96, 0x19, 0xcf, 0x9b, 0xe7, 0xcc, 0xf8, 0x91, 0x41, 0xc9, 0xc7, 0x13, 0x0c, 0x58, 0xf9,
+	0x09, 0x13, 0xc5, 0x78, 0x19, 0x54, 0xe1, 0x35, 0xf0, 0xa7, 0x82, 0x2b, 0x4e, 0xa9, 0xd1, 0xf8,
+	0xd7, 0x74, 0x15, 0x6e, 0xdc, 0x4f, 0xb8, 0x2c, 0xb8, 0x8c, 0x8d, 0x22, 0xb0, 0xc0, 0xca, 0x37,
+	0xba, 0x19, 0xcf, 0xb8, 0xe5, 0xf5, 0x2f, 0xcb, 0x0e, 0x4a, 0x42, 0x76, 0x8d, 0x01, 0xa6, 0xfb,
+	0x35, 0xdd, 0x20, 0xcb, 0xd6, 0x8e, 0x0b, 0xcf, 0xe9, 0x3b, 0xc3, 0x4e, 0x34, 0xc7, 0xfa, 0x59,
+	0x81, 0x0a, 0x52, 0x50, 0xe0, 0x2d, 0xf4, 0x9d, 0xe1, 0x4a, 0x34, 0xc7, 0xaf, 0x86, 0xdf, 0x4e,
+	0xb6, 0x9e, 0x34, 0xdb, 0x54, 0xed, 0x57, 0xe1, 0x18, 0x15, 0x84, 0xfe, 0x7e, 0xfd, 0xa6, 0x56,
+	0x58, 0x4a, 0xc6, 0xcb, 0x0f, 0x53, 0xfd, 0x8a, 0xbb, 0x83, 0xcf, 0x0b, 0xe4, 0xd6, 0x5b, 0x60,
+	0x13, 0x4c, 0x77, 0x70, 0xc2, 0x2a, 0x14, 0x33, 0x7a, 0x97, 0xb4, 0x53, 0x96, 0xa1, 0x54, 0xcd,
+	0xca, 0x06, 0xd1, 0x47, 0x84, 0x14, 0x28, 0x25, 0x64, 0x18, 0xb3, 0xd4, 0xac, 0xec, 0x44, 0x9d,
+	0x86, 0xd9, 0x4d, 0xe9, 0x63, 0xb2, 0x8a, 0x05, 0x53, 0x0a, 0x45, 0x9c, 0xe4, 0xc0, 0x4a, 0x6f,
+	0xb1, 0xef, 0x0c, 0x57, 0xa3, 0x95, 0x86, 0x7c, 0xad, 0x39, 0xed, 0x2d, 0xb1, 0x4c, 0x51, 0x78,
+	0xae, 0xf5, 0xb6, 0x88, 0x3e, 0x24, 0x1d, 0x81, 0x09, 0x9b, 0x32, 0x2c, 0x95, 0xb7, 0x64, 0xad,
+	0xe7, 0x84, 0x9e, 0x82, 0x82, 0x1f, 0x97, 0xca, 0x6b, 0xdb, 0x29, 0x8b, 0x34, 0x9f, 0x23, 0xcb,
+	0x72, 0xe5, 0xfd, 0xd7, 0x77, 0x86, 0x8b, 0x51, 0x83, 0x68, 0x97, 0x2c, 0xa1, 0x10, 0x5c, 0x78,
+	0xcb, 0x46, 0x6e, 0x81, 0x0e, 0x0c, 0x94, 0xc2, 0x62, 0xaa, 0xa4, 0xd7, 0xe9, 0x3b, 0x43, 0x37,
+	0x9a, 0xe3, 0xc1, 0xd7, 0x45, 0xd2, 0xde, 0x03, 0x01, 0x85, 0xa4, 0xcf, 0xc9, 0x7a, 0x01, 0x75,
+	0x7c, 0x68, 0x42, 0x89, 0x53, 0x9b, 0x0a, 0x43, 0x69, 0xd2, 0x70, 0xa3, 0x3b, 0x05, 0xd4, 0x7f,
+	0x04, 0xc6, 0x50, 0xd2, 0xa7, 0xe4, 0xb6, 0x40, 0x85, 0xa5, 0xce, 0x34, 0x1e, 0x4f, 0x78, 0x72,
+	0x24, 0x4d, 0x40, 0x6e, 0xb4, 0x36, 0xe7, 0x47, 0x86, 0xa6, 0x87, 0x64, 0x5d, 0x6f, 0x95, 0x0a,
+	0x8c, 0x58, 0xe5, 0x02, 0x65, 0xce, 0x27, 0xa9, 0x89, 0xab, 0x33, 0x0a, 0x4f, 0xcf, 0x37, 0x5b,
+	0x3f, 0xcf, 0x37, 0x1f, 0xd8, 0xf6, 0x64, 0x7a, 0xe4, 0x33, 0x1e, 0x14, 0xa0, 0x72, 0xff, 0x1d,
+	0x66, 0x90, 0xcc, 0x76, 0x30, 0xf9, 0x7e, 0xb2, 0x45, 0x9a, 0x72, 0x77, 0x30, 0x89, 0xba, 0x37,
+	0xfc, 0xf6, 0xaf, 0xec, 0xe8, 0x0b, 0x72, 0x4f, 0xff, 0x8d, 0x8a, 0x2b, 0x8c, 0xf1, 0xaa, 0xf5,
+	0xb8, 0x02, 0x90, 0x26, 0x7a, 0x37, 0xea, 0x16, 0x50, 0x1f, 0x70, 0x85, 0xf3, 0x4f, 0xe2, 0x00,
+	0x40, 0xd2, 0x97, 0xc4, 0xfb, 0xc7, 0xd8, 0x78, 0xa6, 0x50, 0x9a, 0x5e, 0xdc, 0x68, 0xfd, 0xef,
+	0xb9, 0x91, 0x7e, 0x48, 0x9f, 0x11, 0x6a, 0x06, 0x01, 0x64, 0x3c, 0x45, 0x61, 0x53, 0x30, 0x7d,
+	0xb9, 0xd1, 0x9a, 0x1e, 0x01, 0x90, 0x7b, 0x28, 0x4c, 0x0a, 0xb4, 0x47, 0xfe, 0xcf, 0x1a, 0x5d,
+	0x05, 0x60, 0xda, 0x73, 0xa3, 0x4e, 0x66, 0x14, 0x07, 0x00, 0x34, 0xb4, 0x1d, 0xb0, 0xe6, 0x12,
+	0x62, 0x55, 0x37, 0xaf, 0xb0, 0x6c, 0x94, 0x7a, 0xd3, 0xf5, 0x95, 0x98, 0xfd, 0xa3, 0xf7, 0xa7,
+	0x17, 0x3d, 0xe7, 0xec, 0xa2, 0xe7, 0xfc, 0xba, 0xe8, 0x39, 0x5f, 0x2e, 0x7b, 0xad, 0xb3, 0xcb,
+	0x5e, 0xeb, 0xc7, 0x65, 0xaf, 0xf5, 0x71, 0x3b, 0x63, 0x2a, 0x3f, 0x1e, 0xfb, 0x09, 0x2f, 0x02,
+	0x73, 0xa2, 0x5b, 0x20, 0x25, 0x2a, 0x69, 0x41, 0x50, 0x85, 0x61, 0x50, 0xdf, 0xb8, 0x6c, 0x35,
+	0x9b, 0xa2, 0x1c, 0xb7, 0xcd, 0x39, 0x6e, 0xff, 0x1e, 0x00, 0xdd, 0xb4, 0x18, 0xc2, 0xf9, 0x03,
+	0x00, 0x00,
+}
+
+func (m *InjectedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InjectedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InjectedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintInjection(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Injector) > 0 {
+		i -= len(m.Injector)
+		copy(dAtA[i:], m.Injector)
+		i = encodeVarintInjection(dAtA, i, uint64(len(m.Injector)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintInjection(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Height != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintInjection(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintInjection(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintInjection(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EmitterChain != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.EmitterChain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintInjection(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintInjection(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxInjectedTxBytes != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.MaxInjectedTxBytes))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasPerVaa != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.GasPerVaa))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxVaasPerBlock != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.MaxVaasPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxVoteExtensionBytes != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.MaxVoteExtensionBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxVoteExtensionVaas != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.MaxVoteExtensionVaas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AttestationThreshold.Size()
+		i -= size
+		if _, err := m.AttestationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInjection(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxFailedDeliveries != 0 {
+		i = encodeVarintInjection(dAtA, i, uint64(m.MaxFailedDeliveries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInjection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInjection(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InjectedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Injector)
+	if l > 0 {
+		n += 1 + l + sovInjection(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovInjection(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovInjection(uint64(l))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovInjection(uint64(l))
+	}
+	if m.EmitterChain != 0 {
+		n += 1 + sovInjection(uint64(m.EmitterChain))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovInjection(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovInjection(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovInjection(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInjection(uint64(m.Height))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovInjection(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovInjection(uint64(m.Attempts))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxFailedDeliveries != 0 {
+		n += 1 + sovInjection(uint64(m.MaxFailedDeliveries))
+	}
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovInjection(uint64(m.RetentionBlocks))
+	}
+	l = m.AttestationThreshold.Size()
+	n += 1 + l + sovInjection(uint64(l))
+	if m.MaxVoteExtensionVaas != 0 {
+		n += 1 + sovInjection(uint64(m.MaxVoteExtensionVaas))
+	}
+	if m.MaxVoteExtensionBytes != 0 {
+		n += 1 + sovInjection(uint64(m.MaxVoteExtensionBytes))
+	}
+	if m.MaxVaasPerBlock != 0 {
+		n += 1 + sovInjection(uint64(m.MaxVaasPerBlock))
+	}
+	if m.GasPerVaa != 0 {
+		n += 1 + sovInjection(uint64(m.GasPerVaa))
+	}
+	if m.MaxInjectedTxBytes != 0 {
+		n += 1 + sovInjection(uint64(m.MaxInjectedTxBytes))
+	}
+	return n
+}
+
+func sovInjection(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInjection(x uint64) (n int) {
+	return sovInjection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InjectedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInjection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InjectedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InjectedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Injector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Injector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInjection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInjection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitterChain", wireType)
+			}
+			m.EmitterChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmitterChain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInjection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInjection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailedDeliveries", wireType)
+			}
+			m.MaxFailedDeliveries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailedDeliveries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInjection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteExtensionVaas", wireType)
+			}
+			m.MaxVoteExtensionVaas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteExtensionVaas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteExtensionBytes", wireType)
+			}
+			m.MaxVoteExtensionBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteExtensionBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVaasPerBlock", wireType)
+			}
+			m.MaxVaasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVaasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerVaa", wireType)
+			}
+			m.GasPerVaa = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerVaa |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInjectedTxBytes", wireType)
+			}
+			m.MaxInjectedTxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInjectedTxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInjection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInjection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInjection(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInjection
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInjection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInjection
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInjection
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInjection
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInjection        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInjection          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInjection = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+const ModuleName = "injection"
+
+const (
+	MsgServiceName   = "noble.injection.v1.Msg"
+	QueryServiceName = "noble.injection.v1.Query"
+)
+
+var (
+	ParamsKey              = []byte("params")
+	FailedDeliveryPrefix   = []byte("failed_delivery/")
+	SenderIndexPrefix      = []byte("failed_delivery_by_sender/")
+	RecipientIndexPrefix   = []byte("failed_delivery_by_recipient/")
+	HeightIndexPrefix      = []byte("failed_delivery_by_height/")
+	FailedDeliveryCountKey = []byte("failed_delivery_count")
+)
@@ -0,0 +1,1923 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/injection/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryParams struct {
+}
+
+func (m *QueryParams) Reset()         { *m = QueryParams{} }
+func (m *QueryParams) String() string { return proto.CompactTextString(m) }
+func (*QueryParams) ProtoMessage()    {}
+func (*QueryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd9734334475ed9a, []int{0}
+}
+func (m *QueryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParams.Merge(m, src)
+}
+func (m *QueryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParams proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd9734334475ed9a, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryFailedDeliveries struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedDeliveries) Reset()         { *m = QueryFailedDeliveries{} }
+func (m *QueryFailedDeliveries) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDeliveries) ProtoMessage()    {}
+func (*QueryFailedDeliveries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd9734334475ed9a, []int{2}
+}
+func (m *QueryFailedDeliveries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDeliveries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDeliveries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDeliveries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDeliveries.Merge(m, src)
+}
+func (m *QueryFailedDeliveries) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDeliveries) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDeliveries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDeliveries proto.InternalMessageInfo
+
+func (m *QueryFailedDeliveries) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFailedDeliveriesResponse struct {
+	FailedDeliveries []FailedDelivery    `protobuf:"bytes,1,rep,name=failed_deliveries,json=failedDeliveries,proto3" json:"failed_deliveries"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedDeliveriesResponse) Reset()         { *m = QueryFailedDeliveriesResponse{} }
+func (m *QueryFailedDeliveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDeliveriesResponse) ProtoMessage()    {}
+func (*QueryFailedDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd9734334475ed9a, []int{3}
+}
+func (m *QueryFailedDeliveriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDeliveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDeliveriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDeliveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDeliveriesResponse.Merge(m, src)
+}
+func (m *QueryFailedDeliveriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDeliveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDeliveriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDeliveriesResponse proto.InternalMessageInfo
+
+func (m *QueryFailedDeliveriesResponse) GetFailedDeliveries() []FailedDelivery {
+	if m != nil {
+		return m.FailedDeliveries
+	}
+	return nil
+}
+
+func (m *QueryFailedDeliveriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFailedDelivery struct {
+	Digest string `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+}
+
+func (m *QueryFailedDelivery) Reset()         { *m = QueryFailedDelivery{} }
+func (m *QueryFailedDelivery) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDelivery) ProtoMessage()    {}
+func (*QueryFailedDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd9734334475ed9a, []int{4}
+}
+func (m *QueryFailedDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDelivery.Merge(m, src)
+}
+func (m *QueryFailedDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDelivery proto.InternalMessageInfo
+
+func (m *QueryFailedDelivery) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+type QueryFailedDeliveryResponse struct {
+	FailedDelivery FailedDelivery `protobuf:"bytes,1,opt,name=failed_delivery,json=failedDelivery,proto3" json:"failed_delivery"`
+}
+
+func (m *QueryFailedDeliveryResponse) Reset()         { *m = QueryFailedDeliveryResponse{} }
+func (m *QueryFailedDeliveryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDeliveryResponse) ProtoMessage()    {}
+func (*QueryFailedDeliveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd9734334475ed9a, []int{5}
+}
+func (m *QueryFailedDeliveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDeliveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDeliveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDeliveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDeliveryResponse.Merge(m, src)
+}
+func (m *QueryFailedDeliveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDeliveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDeliveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDeliveryResponse proto.InternalMessageInfo
+
+func (m *QueryFailedDeliveryResponse) GetFailedDelivery() FailedDelivery {
+	if m != nil {
+		return m.FailedDelivery
+	}
+	return FailedDelivery{}
+}
+
+type QueryFailedDeliveriesBySender struct {
+	Sender     string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedDeliveriesBySender) Reset()         { *m = QueryFailedDeliveriesBySender{} }
+func (m *QueryFailedDeliveriesBySender) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDeliveriesBySender) ProtoMessage()    {}
+func (*QueryFailedDeliveriesBySender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd9734334475ed9a, []int{6}
+}
+func (m *QueryFailedDeliveriesBySender) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDeliveriesBySender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDeliveriesBySender.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDeliveriesBySender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDeliveriesBySender.Merge(m, src)
+}
+func (m *QueryFailedDeliveriesBySender) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDeliveriesBySender) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDeliveriesBySender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDeliveriesBySender proto.InternalMessageInfo
+
+func (m *QueryFailedDeliveriesBySender) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryFailedDeliveriesBySender) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFailedDeliveriesByRecipient struct {
+	Recipient  string             `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedDeliveriesByRecipient) Reset()         { *m = QueryFailedDeliveriesByRecipient{} }
+func (m *QueryFailedDeliveriesByRecipient) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedDeliveriesByRecipient) ProtoMessage()    {}
+func (*QueryFailedDeliveriesByRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd9734334475ed9a, []int{7}
+}
+func (m *QueryFailedDeliveriesByRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedDeliveriesByRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedDeliveriesByRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedDeliveriesByRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedDeliveriesByRecipient.Merge(m, src)
+}
+func (m *QueryFailedDeliveriesByRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedDeliveriesByRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedDeliveriesByRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedDeliveriesByRecipient proto.InternalMessageInfo
+
+func (m *QueryFailedDeliveriesByRecipient) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryFailedDeliveriesByRecipient) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParams)(nil), "noble.injection.v1.QueryParams")
+	proto.RegisterType((*QueryParamsResponse)(nil), "noble.injection.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFailedDeliveries)(nil), "noble.injection.v1.QueryFailedDeliveries")
+	proto.RegisterType((*QueryFailedDeliveriesResponse)(nil), "noble.injection.v1.QueryFailedDeliveriesResponse")
+	proto.RegisterType((*QueryFailedDelivery)(nil), "noble.injection.v1.QueryFailedDelivery")
+	proto.RegisterType((*QueryFailedDeliveryResponse)(nil), "noble.injection.v1.QueryFailedDeliveryResponse")
+	proto.RegisterType((*QueryFailedDeliveriesBySender)(nil), "noble.injection.v1.QueryFailedDeliveriesBySender")
+	proto.RegisterType((*QueryFailedDeliveriesByRecipient)(nil), "noble.injection.v1.QueryFailedDeliveriesByRecipient")
+}
+
+func init() { proto.RegisterFile("noble/injection/v1/query.proto", fileDescriptor_bd9734334475ed9a) }
+
+var fileDescriptor_bd9734334475ed9a = []byte{
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6b, 0x13, 0x4f,
+	0x18, 0xce, 0xf4, 0xc7, 0x2f, 0xd0, 0x29, 0xd6, 0x76, 0xfc, 0x43, 0xd9, 0xd6, 0x6d, 0x59, 0xd0,
+	0xd4, 0x62, 0x77, 0xd8, 0x54, 0x11, 0x91, 0x5e, 0x82, 0x54, 0x2f, 0x42, 0x5d, 0xc5, 0x43, 0x2f,
+	0x65, 0x92, 0x4c, 0xd7, 0x91, 0x64, 0x67, 0xbb, 0xb3, 0x09, 0x2e, 0xa1, 0x08, 0x9e, 0x7a, 0x14,
+	0xfc, 0x0a, 0x0a, 0x1e, 0xfd, 0x10, 0x3d, 0xf4, 0x58, 0xf0, 0xe2, 0x49, 0xa4, 0x15, 0x3c, 0xf9,
+	0x1d, 0x24, 0x33, 0x9b, 0xfd, 0xd3, 0x6c, 0xcc, 0x36, 0x78, 0x69, 0x67, 0xe6, 0x7d, 0xde, 0x79,
+	0x9f, 0xe7, 0x99, 0x7d, 0x08, 0xd4, 0x5d, 0x5e, 0x6f, 0x51, 0xcc, 0xdc, 0xd7, 0xb4, 0x11, 0x30,
+	0xee, 0xe2, 0xae, 0x85, 0xf7, 0x3b, 0xd4, 0x0f, 0x4d, 0xcf, 0xe7, 0x01, 0x47, 0x48, 0xd6, 0xcd,
+	0xb8, 0x6e, 0x76, 0x2d, 0x6d, 0x9e, 0xb4, 0x99, 0xcb, 0xb1, 0xfc, 0xab, 0x60, 0xda, 0x5a, 0x83,
+	0x8b, 0x36, 0x17, 0xb8, 0x4e, 0x04, 0x55, 0xfd, 0xb8, 0x6b, 0xd5, 0x69, 0x40, 0x2c, 0xec, 0x11,
+	0x87, 0xb9, 0x44, 0xf6, 0x2a, 0xec, 0x62, 0x84, 0x1d, 0xc0, 0xd2, 0xf3, 0xb4, 0xab, 0x0e, 0x77,
+	0xb8, 0x5c, 0xe2, 0xfe, 0x2a, 0x3a, 0x5d, 0x72, 0x38, 0x77, 0x5a, 0x14, 0x13, 0x8f, 0x61, 0xe2,
+	0xba, 0x3c, 0x90, 0xf7, 0x89, 0xa8, 0x6a, 0xe4, 0x68, 0x48, 0x08, 0x4b, 0x8c, 0x71, 0x09, 0xce,
+	0x3c, 0xeb, 0x8f, 0xd9, 0x26, 0x3e, 0x69, 0x0b, 0xe3, 0x05, 0xbc, 0x92, 0xda, 0xda, 0x54, 0x78,
+	0xdc, 0x15, 0x14, 0x6d, 0xc2, 0xb2, 0x27, 0x4f, 0x16, 0xc0, 0x0a, 0x58, 0x9d, 0xa9, 0x6a, 0xe6,
+	0xb0, 0x7c, 0x53, 0xf5, 0xd4, 0xa6, 0x8f, 0xbf, 0x2f, 0x97, 0x3e, 0xff, 0xfa, 0xb2, 0x06, 0xec,
+	0xa8, 0xc9, 0xd8, 0x85, 0xd7, 0xe4, 0xad, 0x5b, 0x84, 0xb5, 0x68, 0xf3, 0x11, 0x6d, 0xb1, 0x2e,
+	0xf5, 0x19, 0x15, 0x68, 0x0b, 0xc2, 0xc4, 0x86, 0xe8, 0xee, 0x5b, 0xa6, 0xf2, 0xc1, 0xec, 0x7b,
+	0x66, 0x2a, 0x0f, 0x22, 0xcf, 0xcc, 0x6d, 0xe2, 0x50, 0x9b, 0xee, 0x77, 0xa8, 0x08, 0xec, 0x54,
+	0xa7, 0x71, 0x04, 0xe0, 0x8d, 0xdc, 0x09, 0xb1, 0x82, 0x1d, 0x38, 0xbf, 0x27, 0x6b, 0xbb, 0xcd,
+	0xb8, 0xb8, 0x00, 0x56, 0xfe, 0x5b, 0x9d, 0xa9, 0x1a, 0x79, 0x62, 0x32, 0x17, 0x85, 0x69, 0x51,
+	0x73, 0x7b, 0xe7, 0x55, 0x3c, 0xce, 0xa8, 0x98, 0x92, 0x2a, 0x2a, 0x63, 0x55, 0x28, 0x62, 0x19,
+	0x19, 0xeb, 0x91, 0xfb, 0xd9, 0xe1, 0xe8, 0x3a, 0x2c, 0x37, 0x99, 0x43, 0x45, 0x20, 0x1d, 0x9a,
+	0xb6, 0xa3, 0x9d, 0xd1, 0x81, 0x8b, 0x39, 0xf0, 0x58, 0xf2, 0x4b, 0x78, 0x39, 0x2b, 0x39, 0x8c,
+	0x1c, 0xbe, 0xa0, 0xe0, 0xd9, 0x8c, 0xe0, 0xd0, 0x78, 0x3b, 0xc2, 0xeb, 0x5a, 0xf8, 0x9c, 0xba,
+	0x4d, 0xea, 0xf7, 0xf9, 0x0a, 0xb9, 0x1a, 0xf0, 0x55, 0x3b, 0xb4, 0x95, 0xe3, 0xd3, 0x24, 0xaf,
+	0x7d, 0x08, 0xe0, 0xca, 0x08, 0x06, 0x36, 0x6d, 0x30, 0x8f, 0x51, 0x37, 0x40, 0x4b, 0x70, 0xda,
+	0x1f, 0x6c, 0x22, 0x1e, 0xc9, 0xc1, 0xbf, 0xa2, 0x52, 0xfd, 0x5d, 0x86, 0xff, 0x4b, 0x2a, 0xa8,
+	0x07, 0xcb, 0x2a, 0x00, 0x68, 0x39, 0xcf, 0xde, 0x54, 0xaa, 0xb4, 0xca, 0x18, 0xc0, 0xe0, 0x05,
+	0x8d, 0xca, 0x61, 0xff, 0x01, 0xde, 0x7d, 0xfd, 0xf9, 0x61, 0x6a, 0x09, 0x69, 0x38, 0x27, 0xce,
+	0x2a, 0x60, 0xe8, 0x23, 0x80, 0x73, 0x43, 0xe1, 0xba, 0x3d, 0x72, 0xcc, 0x79, 0xa8, 0x66, 0x15,
+	0x86, 0xc6, 0xdc, 0xaa, 0x09, 0xb7, 0x0a, 0xba, 0x99, 0xc7, 0x6d, 0x28, 0x6f, 0xe8, 0x13, 0x80,
+	0xb3, 0xe7, 0xbe, 0xed, 0x4a, 0xb1, 0xc9, 0xa1, 0x86, 0x0b, 0x02, 0x63, 0x82, 0x0f, 0x12, 0x82,
+	0x26, 0xba, 0x33, 0x9e, 0x60, 0x88, 0x7b, 0x2a, 0x57, 0x07, 0xe8, 0x08, 0xc0, 0x85, 0x91, 0x5f,
+	0x77, 0x71, 0xaf, 0x06, 0x2d, 0x93, 0xd8, 0x5b, 0x4b, 0xd8, 0xdf, 0x47, 0xf7, 0x0a, 0xd9, 0x8b,
+	0x55, 0xcc, 0x70, 0x4f, 0xfd, 0x3f, 0x40, 0x27, 0x00, 0x2e, 0xfe, 0x2d, 0x22, 0x77, 0x2f, 0xa0,
+	0x24, 0xee, 0x9a, 0x44, 0xcc, 0x93, 0x44, 0xcc, 0x26, 0x7a, 0x58, 0x4c, 0x4c, 0x9c, 0x55, 0xdc,
+	0x8b, 0x97, 0x07, 0xb5, 0xa7, 0xc7, 0xa7, 0x3a, 0x38, 0x39, 0xd5, 0xc1, 0x8f, 0x53, 0x1d, 0xbc,
+	0x3f, 0xd3, 0x4b, 0x27, 0x67, 0x7a, 0xe9, 0xdb, 0x99, 0x5e, 0xda, 0xd9, 0x70, 0x58, 0xf0, 0xaa,
+	0x53, 0x37, 0x1b, 0xbc, 0xad, 0x06, 0xac, 0x13, 0x21, 0x68, 0x20, 0xa2, 0x69, 0x5d, 0xcb, 0xc2,
+	0x6f, 0x52, 0x33, 0x83, 0xd0, 0xa3, 0xa2, 0x5e, 0x96, 0x3f, 0x82, 0x1b, 0x7f, 0x06, 0x00, 0x73,
+	0xa3, 0x84, 0xe9, 0xee, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	FailedDeliveries(ctx context.Context, in *QueryFailedDeliveries, opts ...grpc.CallOption) (*QueryFailedDeliveriesResponse, error)
+	FailedDelivery(ctx context.Context, in *QueryFailedDelivery, opts ...grpc.CallOption) (*QueryFailedDeliveryResponse, error)
+	FailedDeliveriesBySender(ctx context.Context, in *QueryFailedDeliveriesBySender, opts ...grpc.CallOption) (*QueryFailedDeliveriesResponse, error)
+	FailedDeliveriesByRecipient(ctx context.Context, in *QueryFailedDeliveriesByRecipient, opts ...grpc.CallOption) (*QueryFailedDeliveriesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParams, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.injection.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedDeliveries(ctx context.Context, in *QueryFailedDeliveries, opts ...grpc.CallOption) (*QueryFailedDeliveriesResponse, error) {
+	out := new(QueryFailedDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/noble.injection.v1.Query/FailedDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedDelivery(ctx context.Context, in *QueryFailedDelivery, opts ...grpc.CallOption) (*QueryFailedDeliveryResponse, error) {
+	out := new(QueryFailedDeliveryResponse)
+	err := c.cc.Invoke(ctx, "/noble.injection.v1.Query/FailedDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedDeliveriesBySender(ctx context.Context, in *QueryFailedDeliveriesBySender, opts ...grpc.CallOption) (*QueryFailedDeliveriesResponse, error) {
+	out := new(QueryFailedDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/noble.injection.v1.Query/FailedDeliveriesBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedDeliveriesByRecipient(ctx context.Context, in *QueryFailedDeliveriesByRecipient, opts ...grpc.CallOption) (*QueryFailedDeliveriesResponse, error) {
+	out := new(QueryFailedDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/noble.injection.v1.Query/FailedDeliveriesByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParams) (*QueryParamsResponse, error)
+	FailedDeliveries(context.Context, *QueryFailedDeliveries) (*QueryFailedDeliveriesResponse, error)
+	FailedDelivery(context.Context, *QueryFailedDelivery) (*QueryFailedDeliveryResponse, error)
+	FailedDeliveriesBySender(context.Context, *QueryFailedDeliveriesBySender) (*QueryFailedDeliveriesResponse, error)
+	FailedDeliveriesByRecipient(context.Context, *QueryFailedDeliveriesByRecipient) (*QueryFailedDeliveriesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParams) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) FailedDeliveries(ctx context.Context, req *QueryFailedDeliveries) (*QueryFailedDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedDeliveries not implemented")
+}
+func (*UnimplementedQueryServer) FailedDelivery(ctx context.Context, req *QueryFailedDelivery) (*QueryFailedDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedDelivery not implemented")
+}
+func (*UnimplementedQueryServer) FailedDeliveriesBySender(ctx context.Context, req *QueryFailedDeliveriesBySender) (*QueryFailedDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedDeliveriesBySender not implemented")
+}
+func (*UnimplementedQueryServer) FailedDeliveriesByRecipient(ctx context.Context, req *QueryFailedDeliveriesByRecipient) (*QueryFailedDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedDeliveriesByRecipient not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.injection.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedDeliveries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.injection.v1.Query/FailedDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedDeliveries(ctx, req.(*QueryFailedDeliveries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedDelivery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.injection.v1.Query/FailedDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedDelivery(ctx, req.(*QueryFailedDelivery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedDeliveriesBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedDeliveriesBySender)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedDeliveriesBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.injection.v1.Query/FailedDeliveriesBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedDeliveriesBySender(ctx, req.(*QueryFailedDeliveriesBySender))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedDeliveriesByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedDeliveriesByRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedDeliveriesByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.injection.v1.Query/FailedDeliveriesByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedDeliveriesByRecipient(ctx, req.(*QueryFailedDeliveriesByRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.injection.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FailedDeliveries",
+			Handler:    _Query_FailedDeliveries_Handler,
+		},
+		{
+			MethodName: "FailedDelivery",
+			Handler:    _Query_FailedDelivery_Handler,
+		},
+		{
+			MethodName: "FailedDeliveriesBySender",
+			Handler:    _Query_FailedDeliveriesBySender_Handler,
+		},
+		{
+			MethodName: "FailedDeliveriesByRecipient",
+			Handler:    _Query_FailedDeliveriesByRecipient_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/injection/v1/query.proto",
+}
+
+func (m *QueryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDeliveries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDeliveries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDeliveries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDeliveriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDeliveriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDeliveriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedDeliveries) > 0 {
+		for iNdEx := len(m.FailedDeliveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDeliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDeliveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDeliveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDeliveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FailedDelivery.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDeliveriesBySender) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDeliveriesBySender) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDeliveriesBySender) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedDeliveriesByRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedDeliveriesByRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedDeliveriesByRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedDeliveries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedDeliveriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedDeliveries) > 0 {
+		for _, e := range m.FailedDeliveries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedDeliveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FailedDelivery.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedDeliveriesBySender) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedDeliveriesByRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDeliveries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDeliveries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDeliveries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDeliveriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDeliveriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDeliveriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeliveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDeliveries = append(m.FailedDeliveries, FailedDelivery{})
+			if err := m.FailedDeliveries[len(m.FailedDeliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDeliveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDeliveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDeliveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDelivery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FailedDelivery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDeliveriesBySender) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDeliveriesBySender: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDeliveriesBySender: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedDeliveriesByRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedDeliveriesByRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedDeliveriesByRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
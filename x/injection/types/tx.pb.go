@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/injection/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetParams is the request of the SetParams action.
+type MsgSetParams struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgSetParams) Reset()         { *m = MsgSetParams{} }
+func (m *MsgSetParams) String() string { return proto.CompactTextString(m) }
+func (*MsgSetParams) ProtoMessage()    {}
+func (*MsgSetParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7cd9eb5d5f3e0a3, []int{0}
+}
+func (m *MsgSetParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetParams.Merge(m, src)
+}
+func (m *MsgSetParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetParams proto.InternalMessageInfo
+
+// MsgSetParamsResponse is the response of the SetParams action.
+type MsgSetParamsResponse struct {
+}
+
+func (m *MsgSetParamsResponse) Reset()         { *m = MsgSetParamsResponse{} }
+func (m *MsgSetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetParamsResponse) ProtoMessage()    {}
+func (*MsgSetParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7cd9eb5d5f3e0a3, []int{1}
+}
+func (m *MsgSetParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetParamsResponse.Merge(m, src)
+}
+func (m *MsgSetParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetParams)(nil), "noble.injection.v1.MsgSetParams")
+	proto.RegisterType((*MsgSetParamsResponse)(nil), "noble.injection.v1.MsgSetParamsResponse")
+}
+
+func init() { proto.RegisterFile("noble/injection/v1/tx.proto", fileDescriptor_c7cd9eb5d5f3e0a3) }
+
+var fileDescriptor_c7cd9eb5d5f3e0a3 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x4f, 0x4b, 0x02, 0x41,
+	0x14, 0xdf, 0x29, 0x12, 0x9c, 0xba, 0xb4, 0x48, 0xe9, 0x06, 0xab, 0x78, 0x12, 0xc1, 0x9d, 0x56,
+	0x6f, 0x41, 0x87, 0xbc, 0x0b, 0xa1, 0x87, 0xa0, 0x4b, 0xac, 0x3a, 0x4c, 0x1b, 0xed, 0xcc, 0xb2,
+	0x6f, 0x12, 0xbb, 0x45, 0xa7, 0xe8, 0xd4, 0x47, 0xf0, 0xd8, 0x51, 0xa2, 0x0f, 0xe1, 0x51, 0x3a,
+	0x75, 0x8a, 0xd0, 0x83, 0x7d, 0x8c, 0x70, 0x66, 0x52, 0x29, 0xa1, 0xcb, 0xb2, 0xef, 0xfd, 0xfe,
+	0xbc, 0xdf, 0x8f, 0xc1, 0x07, 0x5c, 0xb4, 0xaf, 0x29, 0x09, 0xf9, 0x15, 0xed, 0xc8, 0x50, 0x70,
+	0xd2, 0xf3, 0x89, 0xec, 0x7b, 0x71, 0x22, 0xa4, 0xb0, 0x6d, 0x05, 0x7a, 0x0b, 0xd0, 0xeb, 0xf9,
+	0xce, 0x6e, 0x10, 0x85, 0x5c, 0x10, 0xf5, 0xd5, 0x34, 0x67, 0xbf, 0x23, 0x20, 0x12, 0x40, 0x22,
+	0x60, 0x73, 0x79, 0x04, 0xcc, 0x00, 0x39, 0x0d, 0x5c, 0xa8, 0x89, 0xe8, 0xc1, 0x40, 0x19, 0x26,
+	0x98, 0xd0, 0xfb, 0xf9, 0x9f, 0xd9, 0x16, 0xd7, 0xa4, 0x59, 0x5e, 0x57, 0x9c, 0xe2, 0x0b, 0xc2,
+	0x3b, 0x0d, 0x60, 0x2d, 0x2a, 0x4f, 0x83, 0x24, 0x88, 0xc0, 0x3e, 0xc4, 0x29, 0x08, 0x19, 0xa7,
+	0x49, 0x16, 0x15, 0x50, 0x29, 0x5d, 0xcf, 0xbe, 0xbd, 0x56, 0x32, 0xe6, 0xd8, 0x49, 0xb7, 0x9b,
+	0x50, 0x80, 0x96, 0x4c, 0x42, 0xce, 0x9a, 0x86, 0x67, 0x1f, 0xe3, 0x54, 0xac, 0xb4, 0xd9, 0x8d,
+	0x02, 0x2a, 0x6d, 0x57, 0x1d, 0xef, 0x6f, 0x51, 0x4f, 0xbb, 0xd7, 0xd3, 0xa3, 0x8f, 0xbc, 0xf5,
+	0x3c, 0x1b, 0x96, 0x51, 0xd3, 0x88, 0x8e, 0xfc, 0x87, 0x41, 0xde, 0xfa, 0x1a, 0xe4, 0xad, 0xfb,
+	0xd9, 0xb0, 0x6c, 0x3c, 0x1f, 0x67, 0xc3, 0x72, 0xee, 0x77, 0xfa, 0x45, 0xc6, 0xe2, 0x1e, 0xce,
+	0xac, 0x66, 0x6e, 0x52, 0x88, 0x05, 0x07, 0x5a, 0xa5, 0x78, 0xb3, 0x01, 0xcc, 0x3e, 0xc3, 0xe9,
+	0x65, 0x9f, 0xc2, 0xba, 0x34, 0xab, 0x6a, 0xa7, 0xf4, 0x1f, 0xe3, 0xc7, 0xdf, 0xd9, 0xba, 0x9b,
+	0x27, 0xaf, 0x37, 0x46, 0x13, 0x17, 0x8d, 0x27, 0x2e, 0xfa, 0x9c, 0xb8, 0xe8, 0x69, 0xea, 0x5a,
+	0xe3, 0xa9, 0x6b, 0xbd, 0x4f, 0x5d, 0xeb, 0xbc, 0xc6, 0x42, 0x79, 0x79, 0xd3, 0xf6, 0x3a, 0x22,
+	0x22, 0xca, 0xb4, 0x12, 0x00, 0x50, 0x09, 0x7a, 0x20, 0x3d, 0xdf, 0x27, 0xfd, 0x95, 0x46, 0xf2,
+	0x36, 0xa6, 0xd0, 0x4e, 0xa9, 0x97, 0xa8, 0x7d, 0x0f, 0x00, 0x2f, 0x2b, 0x8f, 0xad, 0x3d, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SetParams(ctx context.Context, in *MsgSetParams, opts ...grpc.CallOption) (*MsgSetParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetParams(ctx context.Context, in *MsgSetParams, opts ...grpc.CallOption) (*MsgSetParamsResponse, error) {
+	out := new(MsgSetParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.injection.v1.Msg/SetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SetParams(context.Context, *MsgSetParams) (*MsgSetParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetParams(ctx context.Context, req *MsgSetParams) (*MsgSetParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.injection.v1.Msg/SetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetParams(ctx, req.(*MsgSetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.injection.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetParams",
+			Handler:    _Msg_SetParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/injection/v1/tx.proto",
+}
+
+func (m *MsgSetParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package permissions
+
+import (
+	"context"
+
+	"github.com/noble-assets/noble/v11/x/permissions/keeper"
+	"github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+func InitGenesis(ctx context.Context, k *keeper.Keeper, genesis types.GenesisState) {
+	for _, policy := range genesis.Policies {
+		if err := k.Policies.Set(ctx, policy.TypeUrl, policy); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, protectedDenom := range genesis.ProtectedDenoms {
+		if err := k.ProtectedDenoms.Set(ctx, protectedDenom.Denom, protectedDenom); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, circuitBreaker := range genesis.CircuitBreakers {
+		if err := k.CircuitBreakers.Set(ctx, circuitBreaker.TypeUrl, circuitBreaker); err != nil {
+			panic(err)
+		}
+	}
+
+	// NOTE: The issuer modules are initialized before this module, so their
+	// denoms are known, both at genesis and when this module is
+	// added in a software upgrade.
+	if err := k.ProtectIssuedDenoms(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func ExportGenesis(ctx context.Context, k *keeper.Keeper) *types.GenesisState {
+	policies, err := k.GetPolicies(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	protectedDenoms, err := k.GetProtectedDenoms(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	circuitBreakers, err := k.GetCircuitBreakers(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Policies:        policies,
+		ProtectedDenoms: protectedDenoms,
+		CircuitBreakers: circuitBreakers,
+	}
+}
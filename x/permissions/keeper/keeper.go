@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+type Keeper struct {
+	authority    string
+	cdc          codec.Codec
+	eventService event.Service
+
+	issuerKeepers map[string]types.IssuerKeeper
+
+	Schema          collections.Schema
+	Policies        collections.Map[string, types.Policy]
+	ProtectedDenoms collections.Map[string, types.ProtectedDenom]
+	CircuitBreakers collections.Map[string, types.CircuitBreaker]
+}
+
+func NewKeeper(
+	authority string,
+	cdc codec.Codec,
+	storeService store.KVStoreService,
+	eventService event.Service,
+) *Keeper {
+	builder := collections.NewSchemaBuilder(storeService)
+
+	keeper := &Keeper{
+		authority:    authority,
+		cdc:          cdc,
+		eventService: eventService,
+
+		Policies:        collections.NewMap(builder, types.PolicyPrefix, "policies", collections.StringKey, codec.CollValue[types.Policy](cdc)),
+		ProtectedDenoms: collections.NewMap(builder, types.ProtectedDenomPrefix, "protected_denoms", collections.StringKey, codec.CollValue[types.ProtectedDenom](cdc)),
+		CircuitBreakers: collections.NewMap(builder, types.CircuitBreakerPrefix, "circuit_breakers", collections.StringKey, codec.CollValue[types.CircuitBreaker](cdc)),
+	}
+
+	schema, err := builder.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	keeper.Schema = schema
+	return keeper
+}
+
+// SetIssuerKeepers sets the keepers of the modules issuing tokens on Noble,
+// keyed by module name, whose denoms are protected by default.
+func (k *Keeper) SetIssuerKeepers(issuerKeepers map[string]types.IssuerKeeper) {
+	k.issuerKeepers = issuerKeepers
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+var _ types.MsgServer = &msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+func NewMsgServer(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) SetPolicy(ctx context.Context, msg *types.MsgSetPolicy) (*types.MsgSetPolicyResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidPolicy, err.Error())
+	}
+
+	if err := k.Policies.Set(ctx, msg.Policy.TypeUrl, msg.Policy); err != nil {
+		return nil, errors.Wrap(err, "failed to set policy in state")
+	}
+
+	return &types.MsgSetPolicyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PolicyUpdated{
+		Policy: msg.Policy,
+	})
+}
+
+func (k msgServer) RemovePolicy(ctx context.Context, msg *types.MsgRemovePolicy) (*types.MsgRemovePolicyResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	has, err := k.Policies.Has(ctx, msg.TypeUrl)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get policy from state")
+	}
+	if !has {
+		return nil, errors.Wrapf(types.ErrPolicyNotFound, "%s", msg.TypeUrl)
+	}
+
+	if err := k.Policies.Remove(ctx, msg.TypeUrl); err != nil {
+		return nil, errors.Wrap(err, "failed to remove policy from state")
+	}
+
+	return &types.MsgRemovePolicyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PolicyRemoved{
+		TypeUrl: msg.TypeUrl,
+	})
+}
+
+func (k msgServer) SetProtectedDenom(ctx context.Context, msg *types.MsgSetProtectedDenom) (*types.MsgSetProtectedDenomResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := msg.ProtectedDenom.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidProtectedDenom, err.Error())
+	}
+	if module := msg.ProtectedDenom.IssuerModule; module != "" && k.issuerKeepers[module] == nil {
+		return nil, errors.Wrapf(types.ErrInvalidProtectedDenom, "unknown issuer module %s", module)
+	}
+
+	if err := k.ProtectedDenoms.Set(ctx, msg.ProtectedDenom.Denom, msg.ProtectedDenom); err != nil {
+		return nil, errors.Wrap(err, "failed to set protected denom in state")
+	}
+
+	return &types.MsgSetProtectedDenomResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ProtectedDenomUpdated{
+		ProtectedDenom: msg.ProtectedDenom,
+	})
+}
+
+func (k msgServer) RemoveProtectedDenom(ctx context.Context, msg *types.MsgRemoveProtectedDenom) (*types.MsgRemoveProtectedDenomResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	has, err := k.ProtectedDenoms.Has(ctx, msg.Denom)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get protected denom from state")
+	}
+	if !has {
+		return nil, errors.Wrapf(types.ErrProtectedDenomNotFound, "%s", msg.Denom)
+	}
+
+	if err := k.ProtectedDenoms.Remove(ctx, msg.Denom); err != nil {
+		return nil, errors.Wrap(err, "failed to remove protected denom from state")
+	}
+
+	return &types.MsgRemoveProtectedDenomResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ProtectedDenomRemoved{
+		Denom: msg.Denom,
+	})
+}
+
+func (k msgServer) SetCollateralCreators(ctx context.Context, msg *types.MsgSetCollateralCreators) (*types.MsgSetCollateralCreatorsResponse, error) {
+	protectedDenom, err := k.ProtectedDenoms.Get(ctx, msg.Denom)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return nil, errors.Wrapf(types.ErrProtectedDenomNotFound, "%s", msg.Denom)
+		}
+
+		return nil, errors.Wrap(err, "failed to get protected denom from state")
+	}
+
+	if msg.Signer != k.authority {
+		issuer := k.GetIssuer(ctx, protectedDenom)
+		if issuer == "" || msg.Signer != issuer {
+			return nil, errors.Wrapf(types.ErrInvalidIssuer, "got %s", msg.Signer)
+		}
+	}
+
+	if err := types.ValidateCollateralCreators(msg.Creators); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidProtectedDenom, err.Error())
+	}
+
+	protectedDenom.CollateralCreators = msg.Creators
+	if err := k.ProtectedDenoms.Set(ctx, msg.Denom, protectedDenom); err != nil {
+		return nil, errors.Wrap(err, "failed to set protected denom in state")
+	}
+
+	return &types.MsgSetCollateralCreatorsResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ProtectedDenomUpdated{
+		ProtectedDenom: protectedDenom,
+	})
+}
+
+func (k msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	circuitBreaker := types.CircuitBreaker{TypeUrl: msg.TypeUrl, ExpiryHeight: msg.ExpiryHeight}
+	if err := circuitBreaker.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidCircuitBreaker, err.Error())
+	}
+	if height := sdk.UnwrapSDKContext(ctx).BlockHeight(); circuitBreaker.Expired(height) {
+		return nil, errors.Wrapf(types.ErrInvalidCircuitBreaker, "expiry height must be greater than %d", height)
+	}
+
+	if err := k.CircuitBreakers.Set(ctx, msg.TypeUrl, circuitBreaker); err != nil {
+		return nil, errors.Wrap(err, "failed to set circuit breaker in state")
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.CircuitBreakerTripped{
+		TypeUrl:      msg.TypeUrl,
+		ExpiryHeight: msg.ExpiryHeight,
+	})
+}
+
+func (k msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	has, err := k.CircuitBreakers.Has(ctx, msg.TypeUrl)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get circuit breaker from state")
+	}
+	if !has {
+		return nil, errors.Wrapf(types.ErrCircuitBreakerNotFound, "%s", msg.TypeUrl)
+	}
+
+	if err := k.CircuitBreakers.Remove(ctx, msg.TypeUrl); err != nil {
+		return nil, errors.Wrap(err, "failed to remove circuit breaker from state")
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.CircuitBreakerReset{
+		TypeUrl: msg.TypeUrl,
+	})
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v11/testutil"
+	"github.com/noble-assets/noble/v11/x/permissions/keeper"
+	"github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+func TestSetCollateralCreators(t *testing.T) {
+	// ARRANGE: Protect a denom whose issuer module reports its current owner.
+	k, ctx := testutil.SetupKeeper(t, types.ModuleName, keeper.NewKeeper)
+	server := keeper.NewMsgServer(k)
+
+	owner := sdk.AccAddress("owner").String()
+	newOwner := sdk.AccAddress("new-owner").String()
+	creator := sdk.AccAddress("creator").String()
+
+	issuerKeeper := mockIssuerKeeper{"uusdc": owner, "uusdn": ""}
+	k.SetIssuerKeepers(map[string]types.IssuerKeeper{"fiat-tokenfactory": issuerKeeper})
+	require.NoError(t, k.ProtectIssuedDenoms(ctx))
+
+	setCreators := func(signer string, denom string) error {
+		_, err := server.SetCollateralCreators(ctx, &types.MsgSetCollateralCreators{
+			Signer:   signer,
+			Denom:    denom,
+			Creators: []string{creator},
+		})
+		return err
+	}
+
+	// ACT + ASSERT: The current owner can manage the collateral creators.
+	require.NoError(t, setCreators(owner, "uusdc"))
+
+	protectedDenom, err := k.ProtectedDenoms.Get(ctx, "uusdc")
+	require.NoError(t, err)
+	require.Equal(t, []string{creator}, protectedDenom.CollateralCreators)
+
+	// ACT + ASSERT: After an ownership transfer, only the new owner can.
+	issuerKeeper["uusdc"] = newOwner
+	require.ErrorIs(t, setCreators(owner, "uusdc"), types.ErrInvalidIssuer)
+	require.NoError(t, setCreators(newOwner, "uusdc"))
+
+	// ACT + ASSERT: The authority can always manage the collateral creators.
+	require.NoError(t, setCreators(testutil.Authority, "uusdc"))
+
+	// ACT + ASSERT: Denoms without an owner are managed by the authority only.
+	require.ErrorIs(t, setCreators(newOwner, "uusdn"), types.ErrInvalidIssuer)
+	require.NoError(t, setCreators(testutil.Authority, "uusdn"))
+
+	// ACT + ASSERT: Unknown denoms can't be managed.
+	require.ErrorIs(t, setCreators(testutil.Authority, "uusdy"), types.ErrProtectedDenomNotFound)
+}
+
+func TestSetProtectedDenom(t *testing.T) {
+	k, ctx := testutil.SetupKeeper(t, types.ModuleName, keeper.NewKeeper)
+	server := keeper.NewMsgServer(k)
+
+	k.SetIssuerKeepers(map[string]types.IssuerKeeper{"fiat-tokenfactory": mockIssuerKeeper{}})
+
+	_, err := server.SetProtectedDenom(ctx, &types.MsgSetProtectedDenom{
+		Signer:         testutil.Authority,
+		ProtectedDenom: types.ProtectedDenom{Denom: "uusdc", IssuerModule: "fiat-tokenfactory"},
+	})
+	require.NoError(t, err)
+
+	_, err = server.SetProtectedDenom(ctx, &types.MsgSetProtectedDenom{
+		Signer:         testutil.Authority,
+		ProtectedDenom: types.ProtectedDenom{Denom: "uusdy", IssuerModule: "aura"},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidProtectedDenom)
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+var _ types.QueryServer = &queryServer{}
+
+type queryServer struct {
+	*Keeper
+}
+
+func NewQueryServer(keeper *Keeper) types.QueryServer {
+	return &queryServer{Keeper: keeper}
+}
+
+func (k queryServer) Policies(ctx context.Context, req *types.QueryPolicies) (*types.QueryPoliciesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	policies, err := k.GetPolicies(ctx)
+
+	return &types.QueryPoliciesResponse{Policies: policies}, err
+}
+
+func (k queryServer) Policy(ctx context.Context, req *types.QueryPolicy) (*types.QueryPolicyResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	policy, err := k.Keeper.Policies.Get(ctx, req.TypeUrl)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrPolicyNotFound
+		}
+
+		return nil, err
+	}
+
+	return &types.QueryPolicyResponse{Policy: policy}, nil
+}
+
+func (k queryServer) ProtectedDenoms(ctx context.Context, req *types.QueryProtectedDenoms) (*types.QueryProtectedDenomsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	protectedDenoms, err := k.GetProtectedDenoms(ctx)
+
+	return &types.QueryProtectedDenomsResponse{ProtectedDenoms: protectedDenoms}, err
+}
+
+func (k queryServer) ProtectedDenom(ctx context.Context, req *types.QueryProtectedDenom) (*types.QueryProtectedDenomResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	protectedDenom, err := k.Keeper.ProtectedDenoms.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, types.ErrProtectedDenomNotFound
+		}
+
+		return nil, err
+	}
+
+	return &types.QueryProtectedDenomResponse{ProtectedDenom: protectedDenom}, nil
+}
+
+func (k queryServer) CircuitBreakers(ctx context.Context, req *types.QueryCircuitBreakers) (*types.QueryCircuitBreakersResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	circuitBreakers, err := k.GetCircuitBreakers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	tripped := make([]types.CircuitBreaker, 0, len(circuitBreakers))
+	for _, circuitBreaker := range circuitBreakers {
+		if !circuitBreaker.Expired(height) {
+			tripped = append(tripped, circuitBreaker)
+		}
+	}
+
+	return &types.QueryCircuitBreakersResponse{CircuitBreakers: tripped}, nil
+}
@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+// GetPolicies is a utility that returns all policies from state.
+func (k *Keeper) GetPolicies(ctx context.Context) (policies []types.Policy, err error) {
+	err = k.Policies.Walk(ctx, nil, func(_ string, policy types.Policy) (stop bool, err error) {
+		policies = append(policies, policy)
+		return false, nil
+	})
+
+	return
+}
+
+// GetMatchingPolicy is a utility that returns the most specific policy
+// matching a given type URL, if any.
+func (k *Keeper) GetMatchingPolicy(ctx context.Context, typeUrl string) (policy types.Policy, found bool, err error) {
+	err = k.Policies.Walk(ctx, nil, func(_ string, candidate types.Policy) (stop bool, err error) {
+		if candidate.Matches(typeUrl) && (!found || candidate.MoreSpecific(policy)) {
+			policy, found = candidate, true
+		}
+
+		return false, nil
+	})
+
+	return
+}
+
+// CheckMessage returns the violation of the policy that applies to a
+// message, if any. Messages without a matching policy are not permissioned.
+func (k *Keeper) CheckMessage(ctx context.Context, msg sdk.Msg) (*types.Violation, error) {
+	policy, found, err := k.GetMatchingPolicy(ctx, sdk.MsgTypeURL(msg))
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	conditionsMet, predicate, err := k.conditionsMet(policy, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch policy.Action {
+	case types.ActionAllow:
+		if !conditionsMet {
+			return &types.Violation{Policy: policy, Predicate: predicate}, nil
+		}
+	case types.ActionDeny:
+		if conditionsMet {
+			return &types.Violation{Policy: policy}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// conditionsMet returns if a message satisfies both the signer and field
+// conditions of a policy. If a field condition isn't met, the unsatisfied
+// predicate is returned as well.
+func (k *Keeper) conditionsMet(policy types.Policy, msg sdk.Msg) (bool, *types.Predicate, error) {
+	if len(policy.Signers) > 0 {
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return false, nil, err
+		}
+
+		included := 0
+		for _, signer := range signers {
+			if slices.Contains(policy.Signers, sdk.AccAddress(signer).String()) {
+				included += 1
+			}
+		}
+
+		switch policy.Action {
+		case types.ActionAllow:
+			if included != len(signers) {
+				return false, nil, nil
+			}
+		case types.ActionDeny:
+			if included == 0 {
+				return false, nil, nil
+			}
+		}
+	}
+
+	if len(policy.Predicates) == 0 {
+		return true, nil, nil
+	}
+
+	bz, err := k.cdc.MarshalJSON(msg)
+	if err != nil {
+		return false, nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var fields map[string]any
+	if err := decoder.Decode(&fields); err != nil {
+		return false, nil, err
+	}
+
+	for _, predicate := range policy.Predicates {
+		value, found := getFieldValue(fields, predicate.Field)
+		if !found || !predicate.Satisfied(value) {
+			return false, &predicate, nil
+		}
+	}
+
+	return true, nil, nil
+}
+
+// GetProtectedDenoms is a utility that returns all protected denoms from state.
+func (k *Keeper) GetProtectedDenoms(ctx context.Context) (protectedDenoms []types.ProtectedDenom, err error) {
+	err = k.ProtectedDenoms.Walk(ctx, nil, func(_ string, protectedDenom types.ProtectedDenom) (stop bool, err error) {
+		protectedDenoms = append(protectedDenoms, protectedDenom)
+		return false, nil
+	})
+
+	return
+}
+
+// ProtectIssuedDenoms protects all denoms issued on Noble that aren't yet
+// protected, allowing their issuer to manage the collateral creators.
+func (k *Keeper) ProtectIssuedDenoms(ctx context.Context) error {
+	for _, module := range slices.Sorted(maps.Keys(k.issuerKeepers)) {
+		for _, denom := range k.issuerKeepers[module].GetIssuedDenoms(ctx) {
+			has, err := k.ProtectedDenoms.Has(ctx, denom)
+			if err != nil {
+				return err
+			}
+			if has {
+				continue
+			}
+
+			protectedDenom := types.ProtectedDenom{Denom: denom, IssuerModule: module}
+			if err := protectedDenom.Validate(); err != nil {
+				return fmt.Errorf("invalid issued denom %s: %w", denom, err)
+			}
+
+			if err := k.ProtectedDenoms.Set(ctx, denom, protectedDenom); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetIssuer returns the account currently controlled by the issuer of a
+// protected denom, as reported by its issuer module. An empty account means
+// that the denom is managed by the authority.
+func (k *Keeper) GetIssuer(ctx context.Context, protectedDenom types.ProtectedDenom) string {
+	issuerKeeper, ok := k.issuerKeepers[protectedDenom.IssuerModule]
+	if !ok {
+		return ""
+	}
+
+	issuer := issuerKeeper.GetIssuer(ctx, protectedDenom.Denom)
+	if _, err := sdk.AccAddressFromBech32(issuer); err != nil {
+		return ""
+	}
+
+	return issuer
+}
+
+// CanCreateCollateral returns if an account is allowed to create a Hyperlane
+// collateral token for a given denom. Denoms that aren't protected can be
+// used as collateral by anyone.
+func (k *Keeper) CanCreateCollateral(ctx context.Context, denom string, creator string) (bool, error) {
+	protectedDenom, err := k.ProtectedDenoms.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return slices.Contains(protectedDenom.CollateralCreators, creator), nil
+}
+
+// GetCircuitBreakers is a utility that returns all circuit breakers from state.
+func (k *Keeper) GetCircuitBreakers(ctx context.Context) (circuitBreakers []types.CircuitBreaker, err error) {
+	err = k.CircuitBreakers.Walk(ctx, nil, func(_ string, circuitBreaker types.CircuitBreaker) (stop bool, err error) {
+		circuitBreakers = append(circuitBreakers, circuitBreaker)
+		return false, nil
+	})
+
+	return
+}
+
+// IsTripped returns if the circuit breaker of a given type URL is tripped.
+func (k *Keeper) IsTripped(ctx context.Context, typeUrl string) (bool, error) {
+	circuitBreaker, err := k.CircuitBreakers.Get(ctx, typeUrl)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return !circuitBreaker.Expired(sdk.UnwrapSDKContext(ctx).BlockHeight()), nil
+}
+
+// ResetExpiredCircuitBreakers removes all expired circuit breakers from state.
+func (k *Keeper) ResetExpiredCircuitBreakers(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	circuitBreakers, err := k.GetCircuitBreakers(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, circuitBreaker := range circuitBreakers {
+		if !circuitBreaker.Expired(height) {
+			continue
+		}
+
+		if err := k.CircuitBreakers.Remove(ctx, circuitBreaker.TypeUrl); err != nil {
+			return err
+		}
+
+		err = k.eventService.EventManager(ctx).Emit(ctx, &types.CircuitBreakerReset{
+			TypeUrl: circuitBreaker.TypeUrl,
+			Expired: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getFieldValue is a utility that returns the scalar value of a dot
+// separated field path inside a JSON encoded message.
+func getFieldValue(fields map[string]any, path string) (string, bool) {
+	var value any = fields
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return "", false
+		}
+
+		value, ok = object[key]
+		if !ok {
+			return "", false
+		}
+	}
+
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case json.Number, bool:
+		return fmt.Sprint(value), true
+	default:
+		return "", false
+	}
+}
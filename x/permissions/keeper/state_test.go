@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/noble/v11/testutil"
+	"github.com/noble-assets/noble/v11/x/permissions/keeper"
+	"github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+func TestGetMatchingPolicy(t *testing.T) {
+	k, ctx := testutil.SetupKeeper(t, types.ModuleName, keeper.NewKeeper)
+
+	for _, policy := range []types.Policy{
+		{TypeUrl: "/cosmos.*", Action: types.ActionDeny},
+		{TypeUrl: "/cosmos.bank.*", Action: types.ActionAllow},
+		{TypeUrl: "/cosmos.bank.v1beta1.*", Action: types.ActionDeny},
+		{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Action: types.ActionAllow},
+	} {
+		require.NoError(t, k.Policies.Set(ctx, policy.TypeUrl, policy))
+	}
+
+	tests := []struct {
+		typeUrl  string
+		expected string
+	}{
+		// An exact pattern is preferred over all wildcard patterns.
+		{typeUrl: "/cosmos.bank.v1beta1.MsgSend", expected: "/cosmos.bank.v1beta1.MsgSend"},
+		// The longest matching wildcard pattern is preferred.
+		{typeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", expected: "/cosmos.bank.v1beta1.*"},
+		{typeUrl: "/cosmos.bank.v2.MsgSend", expected: "/cosmos.bank.*"},
+		{typeUrl: "/cosmos.staking.v1beta1.MsgDelegate", expected: "/cosmos.*"},
+		// Type URLs without a matching pattern aren't permissioned.
+		{typeUrl: "/noble.swap.v1.MsgSwap", expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.typeUrl, func(t *testing.T) {
+			policy, found, err := k.GetMatchingPolicy(ctx, tc.typeUrl)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected != "", found)
+			require.Equal(t, tc.expected, policy.TypeUrl)
+		})
+	}
+}
+
+func TestCheckMessageSigners(t *testing.T) {
+	alice := sdk.AccAddress("alice").String()
+	bob := sdk.AccAddress("bob").String()
+	charlie := sdk.AccAddress("charlie").String()
+
+	// multiSend is a test utility that returns a message signed by all senders.
+	multiSend := func(senders ...string) sdk.Msg {
+		msg := &banktypes.MsgMultiSend{}
+		for _, sender := range senders {
+			msg.Inputs = append(msg.Inputs, banktypes.Input{Address: sender})
+		}
+		return msg
+	}
+
+	tests := []struct {
+		name     string
+		action   types.Action
+		signers  []string
+		msg      sdk.Msg
+		violated bool
+	}{
+		{name: "allow: single included signer", action: types.ActionAllow, signers: []string{alice}, msg: multiSend(alice)},
+		{name: "allow: single excluded signer", action: types.ActionAllow, signers: []string{alice}, msg: multiSend(bob), violated: true},
+		{name: "allow: all signers included", action: types.ActionAllow, signers: []string{alice, bob}, msg: multiSend(alice, bob)},
+		{name: "allow: some signers included", action: types.ActionAllow, signers: []string{alice}, msg: multiSend(alice, bob), violated: true},
+		{name: "allow: no signer restriction", action: types.ActionAllow, msg: multiSend(charlie)},
+		{name: "deny: single included signer", action: types.ActionDeny, signers: []string{alice}, msg: multiSend(alice), violated: true},
+		{name: "deny: single excluded signer", action: types.ActionDeny, signers: []string{alice}, msg: multiSend(bob)},
+		{name: "deny: some signers included", action: types.ActionDeny, signers: []string{alice}, msg: multiSend(bob, alice), violated: true},
+		{name: "deny: no signers included", action: types.ActionDeny, signers: []string{charlie}, msg: multiSend(alice, bob)},
+		{name: "deny: no signer restriction", action: types.ActionDeny, msg: multiSend(charlie), violated: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := testutil.SetupKeeper(t, types.ModuleName, keeper.NewKeeper)
+
+			typeUrl := sdk.MsgTypeURL(tc.msg)
+			policy := types.Policy{TypeUrl: typeUrl, Action: tc.action, Signers: tc.signers}
+			require.NoError(t, k.Policies.Set(ctx, typeUrl, policy))
+
+			violation, err := k.CheckMessage(ctx, tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.violated, violation != nil)
+		})
+	}
+}
+
+// mockIssuerKeeper is a test utility that issues a fixed set of denoms,
+// mapped to their current owner.
+type mockIssuerKeeper map[string]string
+
+func (k mockIssuerKeeper) GetIssuedDenoms(_ context.Context) []string {
+	return slices.Sorted(maps.Keys(k))
+}
+
+func (k mockIssuerKeeper) GetIssuer(_ context.Context, denom string) string {
+	return k[denom]
+}
+
+func TestProtectIssuedDenoms(t *testing.T) {
+	k, ctx := testutil.SetupKeeper(t, types.ModuleName, keeper.NewKeeper)
+
+	issuer := sdk.AccAddress("issuer").String()
+	creator := sdk.AccAddress("creator").String()
+
+	k.SetIssuerKeepers(map[string]types.IssuerKeeper{
+		"fiat-tokenfactory": mockIssuerKeeper{"uusdc": issuer},
+		"florin":            mockIssuerKeeper{"ueure": "invalid", "uusyc": issuer},
+		"dollar":            mockIssuerKeeper{"uusdn": ""},
+	})
+
+	// Denoms that are already protected are left untouched.
+	existing := types.ProtectedDenom{Denom: "uusyc", CollateralCreators: []string{creator}}
+	require.NoError(t, k.ProtectedDenoms.Set(ctx, existing.Denom, existing))
+
+	require.NoError(t, k.ProtectIssuedDenoms(ctx))
+
+	protectedDenoms, err := k.GetProtectedDenoms(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.ProtectedDenom{
+		{Denom: "ueure", IssuerModule: "florin"},
+		{Denom: "uusdc", IssuerModule: "fiat-tokenfactory"},
+		{Denom: "uusdn", IssuerModule: "dollar"},
+		existing,
+	}, protectedDenoms)
+
+	// Only valid accounts are returned as the issuer of a denom.
+	require.Equal(t, issuer, k.GetIssuer(ctx, protectedDenoms[1]))
+	require.Empty(t, k.GetIssuer(ctx, protectedDenoms[0]))
+	require.Empty(t, k.GetIssuer(ctx, protectedDenoms[2]))
+	require.Empty(t, k.GetIssuer(ctx, existing))
+}
@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package permissions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	modulev1 "github.com/noble-assets/noble/v11/api/permissions/module/v1"
+	"github.com/noble-assets/noble/v11/x/permissions/keeper"
+	"github.com/noble-assets/noble/v11/x/permissions/types"
+)
+
+const ConsensusVersion = 1
+
+var (
+	_ module.AppModuleBasic      = AppModule{}
+	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
+	_ module.HasConsensusVersion = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.HasServices         = AppModule{}
+)
+
+//
+
+type AppModuleBasic struct{}
+
+func NewAppModuleBasic() AppModuleBasic {
+	return AppModuleBasic{}
+}
+
+func (AppModuleBasic) Name() string { return types.ModuleName }
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+func (AppModuleBasic) RegisterInterfaces(reg codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(reg)
+}
+
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genesis types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genesis); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return genesis.Validate()
+}
+
+//
+
+type AppModule struct {
+	AppModuleBasic
+
+	keeper *keeper.Keeper
+}
+
+func NewAppModule(keeper *keeper.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: NewAppModuleBasic(),
+		keeper:         keeper,
+	}
+}
+
+func (AppModule) IsOnePerModuleType() {}
+
+func (AppModule) IsAppModule() {}
+
+func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
+
+func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) {
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &genesis)
+
+	InitGenesis(ctx, m.keeper, genesis)
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genesis := ExportGenesis(ctx, m.keeper)
+	return cdc.MustMarshalJSON(genesis)
+}
+
+func (m AppModule) BeginBlock(ctx context.Context) error {
+	return m.keeper.ResetExpiredCircuitBreakers(ctx)
+}
+
+func (m AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(m.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(m.keeper))
+}
+
+//
+
+func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.MsgServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SetPolicy",
+					Use:            "set-policy [policy]",
+					Short:          "Set the permission policy of a type URL pattern",
+					Example:        `set-policy '{"type_url":"/noble.swap.stableswap.v1.MsgAddLiquidity","action":"ACTION_ALLOW","signers":["noble18vx4czzv4rgrfhm0pzhwu5janjdh4ssdkpu8vr"]}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy"}},
+				},
+				{
+					RpcMethod:      "RemovePolicy",
+					Use:            "remove-policy [type-url]",
+					Short:          "Remove the permission policy of a type URL pattern",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "type_url"}},
+				},
+				{
+					RpcMethod:      "SetProtectedDenom",
+					Use:            "set-protected-denom [protected-denom]",
+					Short:          "Protect a denom from being used as Hyperlane collateral",
+					Example:        `set-protected-denom '{"denom":"uusdc","issuer_module":"fiat-tokenfactory","collateral_creators":[]}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "protected_denom"}},
+				},
+				{
+					RpcMethod:      "RemoveProtectedDenom",
+					Use:            "remove-protected-denom [denom]",
+					Short:          "Remove the Hyperlane collateral protection of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "SetCollateralCreators",
+					Use:       "set-collateral-creators [denom] [creators ...]",
+					Short:     "Set the accounts allowed to create Hyperlane collateral tokens for a protected denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "creators", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "TripCircuitBreaker",
+					Use:       "trip-circuit-breaker [type-url] [expiry-height]",
+					Short:     "Halt the execution of a message type, optionally until a block height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "type_url"},
+						{ProtoField: "expiry_height", Optional: true},
+					},
+				},
+				{
+					RpcMethod:      "ResetCircuitBreaker",
+					Use:            "reset-circuit-breaker [type-url]",
+					Short:          "Resume the execution of a message type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "type_url"}},
+				},
+			},
+		},
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.QueryServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Policies",
+					Use:       "policies",
+					Short:     "Query all active permission policies",
+				},
+				{
+					RpcMethod:      "Policy",
+					Use:            "policy [type-url]",
+					Short:          "Query the permission policy of a type URL pattern",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "type_url"}},
+				},
+				{
+					RpcMethod: "ProtectedDenoms",
+					Use:       "protected-denoms",
+					Short:     "Query all denoms protected from being used as Hyperlane collateral",
+				},
+				{
+					RpcMethod:      "ProtectedDenom",
+					Use:            "protected-denom [denom]",
+					Short:          "Query the Hyperlane collateral protection of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "CircuitBreakers",
+					Use:       "circuit-breakers",
+					Short:     "Query all currently tripped circuit breakers",
+				},
+			},
+		},
+	}
+}
+
+//
+
+func init() {
+	appmodule.Register(&modulev1.Module{},
+		appmodule.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config       *modulev1.Module
+	Cdc          codec.Codec
+	StoreService store.KVStoreService
+	EventService event.Service
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	Keeper *keeper.Keeper
+	Module appmodule.AppModule
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	if in.Config.Authority == "" {
+		panic("authority for Permissions module must be set")
+	}
+
+	authority := authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	k := keeper.NewKeeper(
+		authority.String(),
+		in.Cdc,
+		in.StoreService,
+		in.EventService,
+	)
+	m := NewAppModule(k)
+
+	return ModuleOutputs{Keeper: k, Module: m}
+}
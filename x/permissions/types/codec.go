@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetPolicy{}, "noble/permissions/SetPolicy", nil)
+	cdc.RegisterConcrete(&MsgRemovePolicy{}, "noble/permissions/RemovePolicy", nil)
+	cdc.RegisterConcrete(&MsgSetProtectedDenom{}, "noble/permissions/SetProtectedDenom", nil)
+	cdc.RegisterConcrete(&MsgRemoveProtectedDenom{}, "noble/permissions/RemoveProtectedDenom", nil)
+	cdc.RegisterConcrete(&MsgSetCollateralCreators{}, "noble/permissions/SetCollateralCreators", nil)
+	cdc.RegisterConcrete(&MsgTripCircuitBreaker{}, "noble/permissions/TripCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgResetCircuitBreaker{}, "noble/permissions/ResetCircuitBreaker", nil)
+}
+
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPolicy{},
+		&MsgRemovePolicy{},
+		&MsgSetProtectedDenom{},
+		&MsgRemoveProtectedDenom{},
+		&MsgSetCollateralCreators{},
+		&MsgTripCircuitBreaker{},
+		&MsgResetCircuitBreaker{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "context"
+
+// IssuerKeeper defines the interface expected by the Permissions module for
+// the modules issuing tokens on Noble.
+type IssuerKeeper interface {
+	// GetIssuedDenoms returns all denoms issued by the module.
+	GetIssuedDenoms(ctx context.Context) []string
+	// GetIssuer returns the account currently controlled by the issuer of a
+	// denom. An empty account means that the denom is managed by the
+	// authority.
+	GetIssuer(ctx context.Context, denom string) string
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+)
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Policies:        DefaultPolicies(),
+		ProtectedDenoms: []ProtectedDenom{},
+		CircuitBreakers: []CircuitBreaker{},
+	}
+}
+
+func (genesis *GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, policy := range genesis.Policies {
+		if seen[policy.TypeUrl] {
+			return fmt.Errorf("duplicate policy for %s", policy.TypeUrl)
+		}
+		seen[policy.TypeUrl] = true
+
+		if err := policy.Validate(); err != nil {
+			return errors.Wrapf(err, "failed to validate policy for %s", policy.TypeUrl)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, protectedDenom := range genesis.ProtectedDenoms {
+		if seen[protectedDenom.Denom] {
+			return fmt.Errorf("duplicate protected denom %s", protectedDenom.Denom)
+		}
+		seen[protectedDenom.Denom] = true
+
+		if err := protectedDenom.Validate(); err != nil {
+			return errors.Wrapf(err, "failed to validate protected denom %s", protectedDenom.Denom)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, circuitBreaker := range genesis.CircuitBreakers {
+		if seen[circuitBreaker.TypeUrl] {
+			return fmt.Errorf("duplicate circuit breaker for %s", circuitBreaker.TypeUrl)
+		}
+		seen[circuitBreaker.TypeUrl] = true
+
+		if err := circuitBreaker.Validate(); err != nil {
+			return errors.Wrapf(err, "failed to validate circuit breaker for %s", circuitBreaker.TypeUrl)
+		}
+	}
+
+	return nil
+}
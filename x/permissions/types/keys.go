@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+const ModuleName = "permissions"
+
+const (
+	MsgServiceName   = "noble.permissions.v1.Msg"
+	QueryServiceName = "noble.permissions.v1.Query"
+)
+
+var (
+	PolicyPrefix         = []byte("policy/")
+	ProtectedDenomPrefix = []byte("protected_denom/")
+	CircuitBreakerPrefix = []byte("circuit_breaker/")
+)
@@ -0,0 +1,244 @@
+// SPDX-License-Identifier: Apache-2.0
+//
+// Copyright 2025 NASD Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	ismtypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/01_interchain_security/types"
+	hyperlanetypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authoritytypes "github.com/noble-assets/authority/types"
+	swaptypes "swap.noble.xyz/types"
+	stableswaptypes "swap.noble.xyz/types/stableswap"
+)
+
+// DefaultLiquidityOperator is the account allowed to perform liquidity
+// actions on Noble by default.
+const DefaultLiquidityOperator = "noble18vx4czzv4rgrfhm0pzhwu5janjdh4ssdkpu8vr"
+
+// Wildcard is the suffix of a type URL pattern that matches all type URLs
+// starting with the preceding prefix.
+const Wildcard = "*"
+
+// ProtectedMessages contains the type URLs of messages that no policy is
+// allowed to apply to, ensuring that the authority can always recover.
+var ProtectedMessages = []string{
+	sdk.MsgTypeURL(&authoritytypes.MsgExecute{}),
+	sdk.MsgTypeURL(&authoritytypes.MsgTransferOwnership{}),
+	sdk.MsgTypeURL(&authoritytypes.MsgAcceptOwnership{}),
+}
+
+// DefaultPolicies returns the policies that permission all liquidity actions
+// to the liquidity operator, and all Hyperlane actions on Noble, apart from the
+// ones required for permissionless relaying and bridging of existing tokens.
+// Collateral tokens of protected denoms are additionally restricted by the
+// protected denoms registry.
+func DefaultPolicies() []Policy {
+	policies := []Policy{
+		{TypeUrl: "/hyperlane." + Wildcard, Action: ActionDeny},
+		{TypeUrl: "/hyperlane.core.post_dispatch." + Wildcard, Action: ActionAllow},
+	}
+
+	for _, msg := range []sdk.Msg{
+		&warptypes.MsgCreateCollateralToken{},
+		&ismtypes.MsgAnnounceValidator{},
+		&hyperlanetypes.MsgProcessMessage{},
+		&warptypes.MsgSetToken{},
+		&warptypes.MsgEnrollRemoteRouter{},
+		&warptypes.MsgUnrollRemoteRouter{},
+		&warptypes.MsgRemoteTransfer{},
+	} {
+		policies = append(policies, Policy{TypeUrl: sdk.MsgTypeURL(msg), Action: ActionAllow})
+	}
+
+	for _, msg := range []sdk.Msg{
+		&stableswaptypes.MsgAddLiquidity{},
+		&stableswaptypes.MsgRemoveLiquidity{},
+		&swaptypes.MsgWithdrawRewards{},
+	} {
+		policies = append(policies, Policy{
+			TypeUrl: sdk.MsgTypeURL(msg),
+			Action:  ActionAllow,
+			Signers: []string{DefaultLiquidityOperator},
+		})
+	}
+
+	return policies
+}
+
+// Validate performs a stateless validation of a protected denom.
+func (protectedDenom ProtectedDenom) Validate() error {
+	if err := sdk.ValidateDenom(protectedDenom.Denom); err != nil {
+		return err
+	}
+
+	if protectedDenom.IssuerModule != strings.TrimSpace(protectedDenom.IssuerModule) {
+		return fmt.Errorf("invalid issuer module %q", protectedDenom.IssuerModule)
+	}
+
+	return ValidateCollateralCreators(protectedDenom.CollateralCreators)
+}
+
+// ValidateCollateralCreators performs a stateless validation of the
+// collateral creators of a protected denom.
+func ValidateCollateralCreators(creators []string) error {
+	seen := make(map[string]bool)
+	for _, creator := range creators {
+		if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+			return fmt.Errorf("invalid collateral creator %s: %w", creator, err)
+		}
+		if seen[creator] {
+			return fmt.Errorf("duplicate collateral creator %s", creator)
+		}
+		seen[creator] = true
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of a circuit breaker.
+func (circuitBreaker CircuitBreaker) Validate() error {
+	if !strings.HasPrefix(circuitBreaker.TypeUrl, "/") || strings.Contains(circuitBreaker.TypeUrl, Wildcard) {
+		return fmt.Errorf("invalid type url %s", circuitBreaker.TypeUrl)
+	}
+	if slices.Contains(ProtectedMessages, circuitBreaker.TypeUrl) {
+		return fmt.Errorf("circuit breaker cannot halt %s", circuitBreaker.TypeUrl)
+	}
+
+	if circuitBreaker.ExpiryHeight < 0 {
+		return fmt.Errorf("expiry height cannot be negative")
+	}
+
+	return nil
+}
+
+// Expired returns if a circuit breaker is expired at a given block height.
+func (circuitBreaker CircuitBreaker) Expired(height int64) bool {
+	return circuitBreaker.ExpiryHeight != 0 && height >= circuitBreaker.ExpiryHeight
+}
+
+// Violation describes why a message is rejected by a policy.
+type Violation struct {
+	// Policy is the policy that rejected the message.
+	Policy Policy
+	// Predicate is the unsatisfied predicate of an allow policy, if any.
+	Predicate *Predicate
+}
+
+func (violation Violation) String() string {
+	if violation.Predicate != nil {
+		return fmt.Sprintf("field %s does not satisfy policy %s", violation.Predicate.Field, violation.Policy.TypeUrl)
+	}
+
+	return fmt.Sprintf("denied by policy %s", violation.Policy.TypeUrl)
+}
+
+// Matches returns if a type URL is matched by the type URL pattern of a policy.
+func (policy Policy) Matches(typeUrl string) bool {
+	if prefix, ok := strings.CutSuffix(policy.TypeUrl, Wildcard); ok {
+		return strings.HasPrefix(typeUrl, prefix)
+	}
+
+	return policy.TypeUrl == typeUrl
+}
+
+// MoreSpecific returns if a policy is more specific than another policy
+// matching the same type URL. Exact patterns are more specific than
+// wildcard patterns, and longer wildcard patterns are more specific than
+// shorter ones.
+func (policy Policy) MoreSpecific(other Policy) bool {
+	wildcard := strings.HasSuffix(policy.TypeUrl, Wildcard)
+	otherWildcard := strings.HasSuffix(other.TypeUrl, Wildcard)
+	if wildcard != otherWildcard {
+		return otherWildcard
+	}
+
+	return len(policy.TypeUrl) > len(other.TypeUrl)
+}
+
+// Validate performs a stateless validation of a policy.
+func (policy Policy) Validate() error {
+	if !strings.HasPrefix(policy.TypeUrl, "/") {
+		return fmt.Errorf("type url pattern %s must start with /", policy.TypeUrl)
+	}
+	if strings.Contains(strings.TrimSuffix(policy.TypeUrl, Wildcard), Wildcard) {
+		return fmt.Errorf("type url pattern %s can only contain a wildcard at the end", policy.TypeUrl)
+	}
+
+	for _, typeUrl := range ProtectedMessages {
+		if policy.Matches(typeUrl) {
+			return fmt.Errorf("type url pattern %s cannot match %s", policy.TypeUrl, typeUrl)
+		}
+	}
+
+	if policy.Action != ActionAllow && policy.Action != ActionDeny {
+		return fmt.Errorf("invalid action %s", policy.Action)
+	}
+
+	seen := make(map[string]bool)
+	for _, signer := range policy.Signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid signer %s: %w", signer, err)
+		}
+		if seen[signer] {
+			return fmt.Errorf("duplicate signer %s", signer)
+		}
+		seen[signer] = true
+	}
+
+	for _, predicate := range policy.Predicates {
+		if err := predicate.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of a predicate.
+func (predicate Predicate) Validate() error {
+	if predicate.Field == "" {
+		return fmt.Errorf("predicate field cannot be empty")
+	}
+	if slices.Contains(strings.Split(predicate.Field, "."), "") {
+		return fmt.Errorf("invalid predicate field %s", predicate.Field)
+	}
+
+	if predicate.Operator != OperatorIn && predicate.Operator != OperatorNotIn {
+		return fmt.Errorf("invalid operator %s for predicate field %s", predicate.Operator, predicate.Field)
+	}
+
+	if len(predicate.Values) == 0 {
+		return fmt.Errorf("predicate field %s must have at least one value", predicate.Field)
+	}
+
+	return nil
+}
+
+// Satisfied returns if a field value satisfies a predicate.
+func (predicate Predicate) Satisfied(value string) bool {
+	contains := slices.Contains(predicate.Values, value)
+	if predicate.Operator == OperatorNotIn {
+		return !contains
+	}
+
+	return contains
+}
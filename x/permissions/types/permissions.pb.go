@@ -0,0 +1,1335 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/permissions/v1/permissions.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Action defines the outcome of a policy.
+type Action int32
+
+const (
+	// ACTION_UNSPECIFIED is an invalid action.
+	ActionUnspecified Action = 0
+	// ACTION_ALLOW allows matching messages, as long as all signer and field
+	// conditions of the policy are satisfied.
+	ActionAllow Action = 1
+	// ACTION_DENY denies matching messages, as long as all signer and field
+	// conditions of the policy are satisfied.
+	ActionDeny Action = 2
+)
+
+var Action_name = map[int32]string{
+	0: "ACTION_UNSPECIFIED",
+	1: "ACTION_ALLOW",
+	2: "ACTION_DENY",
+}
+
+var Action_value = map[string]int32{
+	"ACTION_UNSPECIFIED": 0,
+	"ACTION_ALLOW":       1,
+	"ACTION_DENY":        2,
+}
+
+func (x Action) String() string {
+	return proto.EnumName(Action_name, int32(x))
+}
+
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cdcf7986eb50bb56, []int{0}
+}
+
+// Operator defines how the field of a message is compared against a predicate.
+type Operator int32
+
+const (
+	// OPERATOR_UNSPECIFIED is an invalid operator.
+	OperatorUnspecified Operator = 0
+	// OPERATOR_IN is satisfied if the field equals one of the values.
+	OperatorIn Operator = 1
+	// OPERATOR_NOT_IN is satisfied if the field equals none of the values.
+	OperatorNotIn Operator = 2
+)
+
+var Operator_name = map[int32]string{
+	0: "OPERATOR_UNSPECIFIED",
+	1: "OPERATOR_IN",
+	2: "OPERATOR_NOT_IN",
+}
+
+var Operator_value = map[string]int32{
+	"OPERATOR_UNSPECIFIED": 0,
+	"OPERATOR_IN":          1,
+	"OPERATOR_NOT_IN":      2,
+}
+
+func (x Operator) String() string {
+	return proto.EnumName(Operator_name, int32(x))
+}
+
+func (Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cdcf7986eb50bb56, []int{1}
+}
+
+// Policy defines a rule that is applied to all messages matching a type URL pattern.
+type Policy struct {
+	// type_url is the type URL pattern of the messages this policy applies to.
+	// It is either an exact type URL, or a prefix ending with a "*" wildcard.
+	// When multiple policies match a message, the most specific one is applied.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// action is the outcome of this policy.
+	Action Action `protobuf:"varint,2,opt,name=action,proto3,enum=noble.permissions.v1.Action" json:"action,omitempty"`
+	// signers is an optional list of accounts this policy is restricted to.
+	// For allow policies, all signers of a message must be included.
+	// For deny policies, any signer of a message must be included.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// predicates is an optional list of conditions on the fields of a message.
+	Predicates []Predicate `protobuf:"bytes,4,rep,name=predicates,proto3" json:"predicates"`
+}
+
+func (m *Policy) Reset()         { *m = Policy{} }
+func (m *Policy) String() string { return proto.CompactTextString(m) }
+func (*Policy) ProtoMessage()    {}
+func (*Policy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcf7986eb50bb56, []int{0}
+}
+func (m *Policy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Policy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Policy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Policy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Policy.Merge(m, src)
+}
+func (m *Policy) XXX_Size() int {
+	return m.Size()
+}
+func (m *Policy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Policy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Policy proto.InternalMessageInfo
+
+func (m *Policy) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *Policy) GetAction() Action {
+	if m != nil {
+		return m.Action
+	}
+	return ActionUnspecified
+}
+
+func (m *Policy) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *Policy) GetPredicates() []Predicate {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
+// Predicate defines a condition on a field of a message.
+type Predicate struct {
+	// field is the dot separated path of the field, using proto field names.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// operator is the comparison applied to the field.
+	Operator Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=noble.permissions.v1.Operator" json:"operator,omitempty"`
+	// values is the list of values the field is compared against.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *Predicate) Reset()         { *m = Predicate{} }
+func (m *Predicate) String() string { return proto.CompactTextString(m) }
+func (*Predicate) ProtoMessage()    {}
+func (*Predicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcf7986eb50bb56, []int{1}
+}
+func (m *Predicate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Predicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Predicate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Predicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Predicate.Merge(m, src)
+}
+func (m *Predicate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Predicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Predicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Predicate proto.InternalMessageInfo
+
+func (m *Predicate) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *Predicate) GetOperator() Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return OperatorUnspecified
+}
+
+func (m *Predicate) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// ProtectedDenom defines an issuer controlled denom, that can only be used as
+// collateral for a Hyperlane warp route with the consent of its issuer.
+type ProtectedDenom struct {
+	// denom is the protected denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// issuer_module is the optional name of the module issuing the denom. The
+	// account currently owning the denom in this module is allowed to manage
+	// the collateral creators of the denom.
+	IssuerModule string `protobuf:"bytes,2,opt,name=issuer_module,json=issuerModule,proto3" json:"issuer_module,omitempty"`
+	// collateral_creators is the list of accounts allowed to create Hyperlane
+	// collateral tokens for the denom. If empty, no collateral tokens can be created.
+	CollateralCreators []string `protobuf:"bytes,3,rep,name=collateral_creators,json=collateralCreators,proto3" json:"collateral_creators,omitempty"`
+}
+
+func (m *ProtectedDenom) Reset()         { *m = ProtectedDenom{} }
+func (m *ProtectedDenom) String() string { return proto.CompactTextString(m) }
+func (*ProtectedDenom) ProtoMessage()    {}
+func (*ProtectedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcf7986eb50bb56, []int{2}
+}
+func (m *ProtectedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtectedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtectedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtectedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtectedDenom.Merge(m, src)
+}
+func (m *ProtectedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtectedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtectedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtectedDenom proto.InternalMessageInfo
+
+func (m *ProtectedDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ProtectedDenom) GetIssuerModule() string {
+	if m != nil {
+		return m.IssuerModule
+	}
+	return ""
+}
+
+func (m *ProtectedDenom) GetCollateralCreators() []string {
+	if m != nil {
+		return m.CollateralCreators
+	}
+	return nil
+}
+
+// CircuitBreaker defines a tripped circuit breaker, halting the execution of
+// all messages of a specific type URL.
+type CircuitBreaker struct {
+	// type_url is the type URL of the halted messages.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// expiry_height is the block height at which the circuit breaker is
+	// automatically reset. If zero, it has to be reset manually.
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cdcf7986eb50bb56, []int{3}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *CircuitBreaker) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("noble.permissions.v1.Action", Action_name, Action_value)
+	proto.RegisterEnum("noble.permissions.v1.Operator", Operator_name, Operator_value)
+	proto.RegisterType((*Policy)(nil), "noble.permissions.v1.Policy")
+	proto.RegisterType((*Predicate)(nil), "noble.permissions.v1.Predicate")
+	proto.RegisterType((*ProtectedDenom)(nil), "noble.permissions.v1.ProtectedDenom")
+	proto.RegisterType((*CircuitBreaker)(nil), "noble.permissions.v1.CircuitBreaker")
+}
+
+func init() {
+	proto.RegisterFile("noble/permissions/v1/permissions.proto", fileDescriptor_cdcf7986eb50bb56)
+}
+
+var fileDescriptor_cdcf7986eb50bb56 = []byte{
+	// 625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0xe3, 0xa6, 0xa4, 0xcd, 0xa5, 0x4d, 0xd3, 0x6b, 0x80, 0xd4, 0x42, 0x8e, 0x49, 0xa5,
+	0x2a, 0xaa, 0xd4, 0x44, 0x29, 0xb0, 0xb0, 0xe5, 0x1f, 0xc2, 0x52, 0x89, 0x23, 0xb7, 0x15, 0x82,
+	0x25, 0x72, 0xed, 0x6b, 0x7a, 0xe2, 0xe2, 0xb3, 0xee, 0xce, 0xa1, 0x19, 0xd9, 0x50, 0xc4, 0xc0,
+	0xc4, 0x96, 0x89, 0xaf, 0xc0, 0x87, 0xe8, 0x58, 0x21, 0x06, 0x26, 0x84, 0xda, 0x2f, 0x82, 0xec,
+	0xb3, 0x43, 0x40, 0x15, 0x6c, 0xf7, 0xbc, 0xf7, 0x7b, 0xf5, 0x3c, 0xaf, 0x5f, 0x1f, 0xd8, 0xf5,
+	0xe8, 0x29, 0x41, 0x75, 0x1f, 0xb1, 0x11, 0xe6, 0x1c, 0x53, 0x8f, 0xd7, 0xc7, 0x8d, 0x45, 0x59,
+	0xf3, 0x19, 0x15, 0x14, 0x16, 0x23, 0xae, 0xb6, 0x78, 0x31, 0x6e, 0xa8, 0xdb, 0x0e, 0xe5, 0x23,
+	0xca, 0x07, 0x11, 0x53, 0x97, 0x42, 0x36, 0xa8, 0xc5, 0x21, 0x1d, 0x52, 0x59, 0x0f, 0x4f, 0xb2,
+	0x5a, 0xf9, 0xa6, 0x80, 0x4c, 0x9f, 0x12, 0xec, 0x4c, 0xe0, 0x36, 0x58, 0x15, 0x13, 0x1f, 0x0d,
+	0x02, 0x46, 0x4a, 0x8a, 0xae, 0x54, 0xb3, 0xd6, 0x4a, 0xa8, 0x4f, 0x18, 0x81, 0x8f, 0x41, 0xc6,
+	0x76, 0x04, 0xa6, 0x5e, 0x69, 0x49, 0x57, 0xaa, 0xf9, 0x83, 0x07, 0xb5, 0xdb, 0xdc, 0x6b, 0xcd,
+	0x88, 0xb1, 0x62, 0x16, 0x1e, 0x80, 0x15, 0x8e, 0x87, 0x1e, 0x62, 0xbc, 0x94, 0xd6, 0xd3, 0xd5,
+	0x6c, 0xab, 0xf4, 0xf5, 0xcb, 0x7e, 0x31, 0x0e, 0xd5, 0x74, 0x5d, 0x86, 0x38, 0x3f, 0x12, 0x0c,
+	0x7b, 0x43, 0x2b, 0x01, 0x61, 0x17, 0x00, 0x9f, 0x21, 0x17, 0x3b, 0xb6, 0x40, 0xbc, 0xb4, 0xac,
+	0xa7, 0xab, 0xb9, 0x83, 0xf2, 0xed, 0x6e, 0xfd, 0x84, 0x6b, 0x2d, 0x5f, 0xfe, 0x28, 0xa7, 0xac,
+	0x85, 0xc6, 0x4a, 0x00, 0xb2, 0xf3, 0x6b, 0x58, 0x04, 0x77, 0xce, 0x30, 0x22, 0x6e, 0x3c, 0x95,
+	0x14, 0xf0, 0x29, 0x58, 0xa5, 0x3e, 0x62, 0xb6, 0xa0, 0x2c, 0x9e, 0x4a, 0xbb, 0xdd, 0xc7, 0x8c,
+	0x29, 0x6b, 0xce, 0xc3, 0x7b, 0x20, 0x33, 0xb6, 0x49, 0x80, 0xe2, 0xc1, 0xac, 0x58, 0x55, 0x3e,
+	0x29, 0x20, 0xdf, 0x67, 0x54, 0x20, 0x47, 0x20, 0xb7, 0x83, 0x3c, 0x3a, 0x0a, 0xcd, 0xdd, 0xf0,
+	0x90, 0x98, 0x47, 0x02, 0xee, 0x80, 0x75, 0xcc, 0x79, 0x80, 0xd8, 0x60, 0x44, 0xdd, 0x80, 0xa0,
+	0x28, 0x41, 0xd6, 0x5a, 0x93, 0xc5, 0x17, 0x51, 0x0d, 0x1a, 0x60, 0xcb, 0xa1, 0x84, 0xd8, 0x02,
+	0x31, 0x9b, 0x0c, 0x1c, 0x86, 0x42, 0xef, 0xff, 0x7f, 0x4b, 0xf8, 0xbb, 0xa9, 0x1d, 0xf7, 0x54,
+	0xfa, 0x20, 0xdf, 0xc6, 0xcc, 0x09, 0xb0, 0x68, 0x31, 0x64, 0xbf, 0x41, 0xec, 0x5f, 0xdb, 0xde,
+	0x01, 0xeb, 0xe8, 0xc2, 0xc7, 0x6c, 0x32, 0x38, 0x47, 0x78, 0x78, 0x2e, 0xa2, 0x70, 0x69, 0x6b,
+	0x4d, 0x16, 0x9f, 0x47, 0xb5, 0xbd, 0x77, 0x0a, 0xc8, 0xc8, 0x7d, 0xc3, 0x7d, 0x00, 0x9b, 0xed,
+	0x63, 0xc3, 0xec, 0x0d, 0x4e, 0x7a, 0x47, 0xfd, 0x6e, 0xdb, 0x78, 0x66, 0x74, 0x3b, 0x85, 0x94,
+	0x7a, 0x77, 0x3a, 0xd3, 0x37, 0x25, 0x73, 0xe2, 0x71, 0x1f, 0x39, 0xf8, 0x0c, 0x23, 0x17, 0x3e,
+	0x04, 0x6b, 0x31, 0xde, 0x3c, 0x3c, 0x34, 0x5f, 0x16, 0x14, 0x75, 0x63, 0x3a, 0xd3, 0x73, 0x12,
+	0x6c, 0x12, 0x42, 0xdf, 0xc2, 0x32, 0xc8, 0xc5, 0x48, 0xa7, 0xdb, 0x7b, 0x55, 0x58, 0x52, 0xf3,
+	0xd3, 0x99, 0x0e, 0x24, 0xd1, 0x41, 0xde, 0x44, 0x5d, 0x7e, 0xff, 0x59, 0x4b, 0xed, 0x7d, 0x50,
+	0xc0, 0x6a, 0xb2, 0x1d, 0xd8, 0x00, 0x45, 0xb3, 0xdf, 0xb5, 0x9a, 0xc7, 0xa6, 0xf5, 0x57, 0x8e,
+	0xfb, 0xd3, 0x99, 0xbe, 0x95, 0x70, 0x8b, 0x49, 0xca, 0x20, 0x37, 0x6f, 0x31, 0x7a, 0x05, 0x45,
+	0xda, 0x24, 0xa4, 0xe1, 0xc1, 0x5d, 0xb0, 0x31, 0x07, 0x7a, 0xe6, 0x71, 0x08, 0x2d, 0xa9, 0x9b,
+	0xd3, 0x99, 0xbe, 0x9e, 0x40, 0x3d, 0x2a, 0x0c, 0x4f, 0xc6, 0x69, 0x99, 0x97, 0xd7, 0x9a, 0x72,
+	0x75, 0xad, 0x29, 0x3f, 0xaf, 0x35, 0xe5, 0xe3, 0x8d, 0x96, 0xba, 0xba, 0xd1, 0x52, 0xdf, 0x6f,
+	0xb4, 0xd4, 0xeb, 0x27, 0x43, 0x2c, 0xce, 0x83, 0xd3, 0x9a, 0x43, 0x47, 0xf5, 0xe8, 0x1f, 0xdb,
+	0xb7, 0x39, 0x47, 0x82, 0x4b, 0x51, 0x1f, 0x37, 0x1a, 0xf5, 0x8b, 0x3f, 0x9e, 0x7c, 0xb8, 0x0a,
+	0x7e, 0x9a, 0x89, 0xde, 0xe8, 0xa3, 0x5f, 0x03, 0x00, 0x05, 0x54, 0xcd, 0x18, 0x14, 0x04, 0x00,
+	0x00,
+}
+
+func (m *Policy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predicates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Action != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Predicate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Predicate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Predicate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtectedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtectedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtectedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralCreators) > 0 {
+		for iNdEx := len(m.CollateralCreators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CollateralCreators[iNdEx])
+			copy(dAtA[i:], m.CollateralCreators[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.CollateralCreators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.IssuerModule) > 0 {
+		i -= len(m.IssuerModule)
+		copy(dAtA[i:], m.IssuerModule)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.IssuerModule)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPermissions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Policy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovPermissions(uint64(m.Action))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.Predicates) > 0 {
+		for _, e := range m.Predicates {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Predicate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovPermissions(uint64(m.Operator))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProtectedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.IssuerModule)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.CollateralCreators) > 0 {
+		for _, s := range m.CollateralCreators {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovPermissions(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func sovPermissions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPermissions(x uint64) (n int) {
+	return sovPermissions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Policy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, Predicate{})
+			if err := m.Predicates[len(m.Predicates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Predicate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Predicate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Predicate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= Operator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtectedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtectedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtectedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerModule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerModule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralCreators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralCreators = append(m.CollateralCreators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPermissions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPermissions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPermissions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPermissions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPermissions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPermissions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPermissions = fmt.Errorf("proto: unexpected end of group")
+)
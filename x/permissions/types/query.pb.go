@@ -0,0 +1,1990 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/permissions/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryPolicies struct {
+}
+
+func (m *QueryPolicies) Reset()         { *m = QueryPolicies{} }
+func (m *QueryPolicies) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicies) ProtoMessage()    {}
+func (*QueryPolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{0}
+}
+func (m *QueryPolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicies.Merge(m, src)
+}
+func (m *QueryPolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPolicies proto.InternalMessageInfo
+
+type QueryPoliciesResponse struct {
+	Policies []Policy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+}
+
+func (m *QueryPoliciesResponse) Reset()         { *m = QueryPoliciesResponse{} }
+func (m *QueryPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoliciesResponse) ProtoMessage()    {}
+func (*QueryPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{1}
+}
+func (m *QueryPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoliciesResponse.Merge(m, src)
+}
+func (m *QueryPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryPoliciesResponse) GetPolicies() []Policy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type QueryPolicy struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+}
+
+func (m *QueryPolicy) Reset()         { *m = QueryPolicy{} }
+func (m *QueryPolicy) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicy) ProtoMessage()    {}
+func (*QueryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{2}
+}
+func (m *QueryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicy.Merge(m, src)
+}
+func (m *QueryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPolicy proto.InternalMessageInfo
+
+func (m *QueryPolicy) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+type QueryPolicyResponse struct {
+	Policy Policy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *QueryPolicyResponse) Reset()         { *m = QueryPolicyResponse{} }
+func (m *QueryPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicyResponse) ProtoMessage()    {}
+func (*QueryPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{3}
+}
+func (m *QueryPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicyResponse.Merge(m, src)
+}
+func (m *QueryPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryPolicyResponse) GetPolicy() Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return Policy{}
+}
+
+type QueryProtectedDenoms struct {
+}
+
+func (m *QueryProtectedDenoms) Reset()         { *m = QueryProtectedDenoms{} }
+func (m *QueryProtectedDenoms) String() string { return proto.CompactTextString(m) }
+func (*QueryProtectedDenoms) ProtoMessage()    {}
+func (*QueryProtectedDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{4}
+}
+func (m *QueryProtectedDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtectedDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtectedDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtectedDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtectedDenoms.Merge(m, src)
+}
+func (m *QueryProtectedDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtectedDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtectedDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtectedDenoms proto.InternalMessageInfo
+
+type QueryProtectedDenomsResponse struct {
+	ProtectedDenoms []ProtectedDenom `protobuf:"bytes,1,rep,name=protected_denoms,json=protectedDenoms,proto3" json:"protected_denoms"`
+}
+
+func (m *QueryProtectedDenomsResponse) Reset()         { *m = QueryProtectedDenomsResponse{} }
+func (m *QueryProtectedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtectedDenomsResponse) ProtoMessage()    {}
+func (*QueryProtectedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{5}
+}
+func (m *QueryProtectedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtectedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtectedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtectedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtectedDenomsResponse.Merge(m, src)
+}
+func (m *QueryProtectedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtectedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtectedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtectedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryProtectedDenomsResponse) GetProtectedDenoms() []ProtectedDenom {
+	if m != nil {
+		return m.ProtectedDenoms
+	}
+	return nil
+}
+
+type QueryProtectedDenom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryProtectedDenom) Reset()         { *m = QueryProtectedDenom{} }
+func (m *QueryProtectedDenom) String() string { return proto.CompactTextString(m) }
+func (*QueryProtectedDenom) ProtoMessage()    {}
+func (*QueryProtectedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{6}
+}
+func (m *QueryProtectedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtectedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtectedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtectedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtectedDenom.Merge(m, src)
+}
+func (m *QueryProtectedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtectedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtectedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtectedDenom proto.InternalMessageInfo
+
+func (m *QueryProtectedDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryProtectedDenomResponse struct {
+	ProtectedDenom ProtectedDenom `protobuf:"bytes,1,opt,name=protected_denom,json=protectedDenom,proto3" json:"protected_denom"`
+}
+
+func (m *QueryProtectedDenomResponse) Reset()         { *m = QueryProtectedDenomResponse{} }
+func (m *QueryProtectedDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtectedDenomResponse) ProtoMessage()    {}
+func (*QueryProtectedDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{7}
+}
+func (m *QueryProtectedDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtectedDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtectedDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtectedDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtectedDenomResponse.Merge(m, src)
+}
+func (m *QueryProtectedDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtectedDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtectedDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtectedDenomResponse proto.InternalMessageInfo
+
+func (m *QueryProtectedDenomResponse) GetProtectedDenom() ProtectedDenom {
+	if m != nil {
+		return m.ProtectedDenom
+	}
+	return ProtectedDenom{}
+}
+
+type QueryCircuitBreakers struct {
+}
+
+func (m *QueryCircuitBreakers) Reset()         { *m = QueryCircuitBreakers{} }
+func (m *QueryCircuitBreakers) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakers) ProtoMessage()    {}
+func (*QueryCircuitBreakers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{8}
+}
+func (m *QueryCircuitBreakers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakers.Merge(m, src)
+}
+func (m *QueryCircuitBreakers) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakers) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakers proto.InternalMessageInfo
+
+type QueryCircuitBreakersResponse struct {
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,1,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+}
+
+func (m *QueryCircuitBreakersResponse) Reset()         { *m = QueryCircuitBreakersResponse{} }
+func (m *QueryCircuitBreakersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakersResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5cdc51c71f7860a9, []int{9}
+}
+func (m *QueryCircuitBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakersResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakersResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakersResponse) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPolicies)(nil), "noble.permissions.v1.QueryPolicies")
+	proto.RegisterType((*QueryPoliciesResponse)(nil), "noble.permissions.v1.QueryPoliciesResponse")
+	proto.RegisterType((*QueryPolicy)(nil), "noble.permissions.v1.QueryPolicy")
+	proto.RegisterType((*QueryPolicyResponse)(nil), "noble.permissions.v1.QueryPolicyResponse")
+	proto.RegisterType((*QueryProtectedDenoms)(nil), "noble.permissions.v1.QueryProtectedDenoms")
+	proto.RegisterType((*QueryProtectedDenomsResponse)(nil), "noble.permissions.v1.QueryProtectedDenomsResponse")
+	proto.RegisterType((*QueryProtectedDenom)(nil), "noble.permissions.v1.QueryProtectedDenom")
+	proto.RegisterType((*QueryProtectedDenomResponse)(nil), "noble.permissions.v1.QueryProtectedDenomResponse")
+	proto.RegisterType((*QueryCircuitBreakers)(nil), "noble.permissions.v1.QueryCircuitBreakers")
+	proto.RegisterType((*QueryCircuitBreakersResponse)(nil), "noble.permissions.v1.QueryCircuitBreakersResponse")
+}
+
+func init() { proto.RegisterFile("noble/permissions/v1/query.proto", fileDescriptor_5cdc51c71f7860a9) }
+
+var fileDescriptor_5cdc51c71f7860a9 = []byte{
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4f, 0x8b, 0xd3, 0x4e,
+	0x18, 0xc7, 0x3b, 0xbf, 0x1f, 0x5b, 0xbb, 0xb3, 0xb8, 0xd5, 0xb1, 0xca, 0x9a, 0x2d, 0xb1, 0x46,
+	0x59, 0xda, 0x2d, 0x9b, 0xa1, 0x5d, 0xbc, 0x78, 0x11, 0xba, 0xde, 0xd5, 0x82, 0x22, 0x8b, 0x50,
+	0xd2, 0xec, 0x50, 0x83, 0x6d, 0x26, 0x66, 0xd2, 0x6a, 0x14, 0x0f, 0x7a, 0xd2, 0x9b, 0x20, 0xf8,
+	0x12, 0x44, 0x3c, 0xf9, 0x32, 0xf6, 0xb8, 0xe0, 0xc5, 0x93, 0x48, 0x2b, 0xf8, 0x36, 0x24, 0x93,
+	0xbf, 0x33, 0x64, 0xbb, 0xe9, 0xa5, 0x4d, 0x9e, 0xf9, 0xce, 0xf3, 0xfd, 0xcc, 0x97, 0x67, 0x5a,
+	0xd8, 0xb0, 0xe9, 0x70, 0x4c, 0xb0, 0x43, 0xdc, 0x89, 0xc5, 0x98, 0x45, 0x6d, 0x86, 0x67, 0x1d,
+	0xfc, 0x7c, 0x4a, 0x5c, 0x5f, 0x77, 0x5c, 0xea, 0x51, 0x54, 0xe3, 0x0a, 0x3d, 0xa3, 0xd0, 0x67,
+	0x1d, 0xe5, 0xa2, 0x31, 0xb1, 0x6c, 0x8a, 0xf9, 0x67, 0x28, 0x54, 0xb6, 0x4d, 0xca, 0x26, 0x94,
+	0x85, 0x9b, 0xa5, 0x2e, 0x4a, 0x6d, 0x44, 0x47, 0x94, 0x3f, 0xe2, 0xe0, 0x29, 0xaa, 0xd6, 0x47,
+	0x94, 0x8e, 0xc6, 0x04, 0x1b, 0x8e, 0x85, 0x0d, 0xdb, 0xa6, 0x9e, 0xe1, 0x71, 0x83, 0x70, 0x75,
+	0x27, 0x97, 0x2d, 0x0b, 0xc2, 0x75, 0x5a, 0x15, 0x9e, 0x7f, 0x10, 0x58, 0xdd, 0xa7, 0x63, 0xcb,
+	0xb4, 0x08, 0xd3, 0x9e, 0xc0, 0xcb, 0x42, 0xa1, 0x4f, 0x98, 0x43, 0x6d, 0x46, 0xd0, 0x01, 0xac,
+	0x38, 0x51, 0x6d, 0x0b, 0x34, 0xfe, 0x6f, 0x6e, 0x74, 0xeb, 0x7a, 0xde, 0xf1, 0x74, 0xbe, 0xd3,
+	0xef, 0xad, 0x1f, 0xff, 0xba, 0x56, 0xfa, 0xfa, 0xf7, 0xfb, 0x2e, 0xe8, 0x27, 0x1b, 0xb5, 0x26,
+	0xdc, 0x48, 0xbb, 0xfb, 0xe8, 0x2a, 0xac, 0x78, 0xbe, 0x43, 0x06, 0x53, 0x77, 0xbc, 0x05, 0x1a,
+	0xa0, 0xb9, 0xde, 0x3f, 0x17, 0xbc, 0x3f, 0x74, 0xc7, 0xda, 0x23, 0x78, 0x29, 0xa3, 0x4c, 0x28,
+	0xee, 0xc0, 0x32, 0x6f, 0xe6, 0x73, 0xfd, 0x0a, 0x0c, 0xd1, 0x36, 0xed, 0x0a, 0xac, 0x85, 0x7d,
+	0x5d, 0xea, 0x11, 0xd3, 0x23, 0x47, 0x77, 0x89, 0x4d, 0x27, 0x4c, 0x7b, 0x05, 0xeb, 0x79, 0xf5,
+	0xc4, 0xf8, 0x10, 0x5e, 0x70, 0xe2, 0xa5, 0xc1, 0x11, 0x5f, 0x8b, 0x62, 0xb8, 0x79, 0x0a, 0x82,
+	0xd0, 0x28, 0x8b, 0x52, 0x75, 0x24, 0xef, 0x76, 0x7c, 0x56, 0xa1, 0x8e, 0x6a, 0x70, 0x8d, 0x1b,
+	0x45, 0xd1, 0x84, 0x2f, 0xda, 0x0b, 0xb8, 0x9d, 0x23, 0x4e, 0x38, 0x1f, 0xc3, 0xaa, 0xc4, 0x19,
+	0x25, 0xb5, 0x32, 0xe6, 0xa6, 0x88, 0x99, 0x24, 0x77, 0x60, 0xb9, 0xe6, 0xd4, 0xf2, 0x7a, 0x2e,
+	0x31, 0x9e, 0x11, 0x37, 0x4d, 0x4e, 0xaa, 0x67, 0x93, 0x33, 0xc3, 0xa5, 0xc1, 0x30, 0x5a, 0x5b,
+	0x9e, 0x9c, 0xd8, 0x48, 0x48, 0xce, 0x14, 0x3d, 0xba, 0x9f, 0xcb, 0x70, 0x8d, 0x9b, 0xa3, 0x0f,
+	0x00, 0x56, 0xe2, 0x99, 0x45, 0x37, 0xf2, 0x1b, 0x0b, 0x83, 0xad, 0xb4, 0x0b, 0x88, 0xe2, 0x43,
+	0x68, 0xed, 0xf7, 0x01, 0xc0, 0xbb, 0x1f, 0x7f, 0x3e, 0xfd, 0xd7, 0x40, 0x2a, 0xce, 0xbf, 0x5d,
+	0xb1, 0xfd, 0x5b, 0x00, 0xcb, 0xd1, 0x84, 0x5f, 0x3f, 0xcb, 0xc4, 0x57, 0x5a, 0x67, 0x4a, 0x12,
+	0x8a, 0x56, 0x4a, 0xa1, 0xa2, 0xfa, 0x12, 0x0a, 0x1f, 0x7d, 0x01, 0xb0, 0x2a, 0xcd, 0x32, 0xda,
+	0x5d, 0xe6, 0x24, 0x6a, 0x95, 0x6e, 0x71, 0x6d, 0x82, 0xb7, 0x9f, 0xe2, 0x35, 0xd1, 0xce, 0x29,
+	0x78, 0xd2, 0x25, 0x42, 0xdf, 0x00, 0xdc, 0x94, 0x06, 0xbf, 0x55, 0xd8, 0x5b, 0xe9, 0x14, 0x96,
+	0x26, 0x94, 0xb7, 0x53, 0x4a, 0x8c, 0xf6, 0x0a, 0x51, 0xe2, 0xd7, 0xfc, 0xeb, 0x0d, 0x4f, 0x55,
+	0x9a, 0xf3, 0xa5, 0xa9, 0x4a, 0x5a, 0xa5, 0x5b, 0x5c, 0xbb, 0x52, 0xaa, 0xf2, 0x05, 0xeb, 0xdd,
+	0x3b, 0x9e, 0xab, 0xe0, 0x64, 0xae, 0x82, 0xdf, 0x73, 0x15, 0x7c, 0x5c, 0xa8, 0xa5, 0x93, 0x85,
+	0x5a, 0xfa, 0xb9, 0x50, 0x4b, 0x87, 0xb7, 0x46, 0x96, 0xf7, 0x74, 0x3a, 0xd4, 0x4d, 0x3a, 0x09,
+	0x7b, 0xed, 0x19, 0x8c, 0x11, 0x8f, 0x45, 0x8d, 0x67, 0x9d, 0x0e, 0x7e, 0x29, 0xb4, 0x0f, 0x7e,
+	0x91, 0xd9, 0xb0, 0xcc, 0xff, 0x2f, 0xf6, 0xff, 0x0d, 0x00, 0xc8, 0x53, 0x54, 0xf0, 0xf5, 0x06,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Policies(ctx context.Context, in *QueryPolicies, opts ...grpc.CallOption) (*QueryPoliciesResponse, error)
+	Policy(ctx context.Context, in *QueryPolicy, opts ...grpc.CallOption) (*QueryPolicyResponse, error)
+	ProtectedDenoms(ctx context.Context, in *QueryProtectedDenoms, opts ...grpc.CallOption) (*QueryProtectedDenomsResponse, error)
+	ProtectedDenom(ctx context.Context, in *QueryProtectedDenom, opts ...grpc.CallOption) (*QueryProtectedDenomResponse, error)
+	CircuitBreakers(ctx context.Context, in *QueryCircuitBreakers, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Policies(ctx context.Context, in *QueryPolicies, opts ...grpc.CallOption) (*QueryPoliciesResponse, error) {
+	out := new(QueryPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/noble.permissions.v1.Query/Policies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Policy(ctx context.Context, in *QueryPolicy, opts ...grpc.CallOption) (*QueryPolicyResponse, error) {
+	out := new(QueryPolicyResponse)
+	err := c.cc.Invoke(ctx, "/noble.permissions.v1.Query/Policy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtectedDenoms(ctx context.Context, in *QueryProtectedDenoms, opts ...grpc.CallOption) (*QueryProtectedDenomsResponse, error) {
+	out := new(QueryProtectedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/noble.permissions.v1.Query/ProtectedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtectedDenom(ctx context.Context, in *QueryProtectedDenom, opts ...grpc.CallOption) (*QueryProtectedDenomResponse, error) {
+	out := new(QueryProtectedDenomResponse)
+	err := c.cc.Invoke(ctx, "/noble.permissions.v1.Query/ProtectedDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreakers(ctx context.Context, in *QueryCircuitBreakers, opts ...grpc.CallOption) (*QueryCircuitBreakersResponse, error) {
+	out := new(QueryCircuitBreakersResponse)
+	err := c.cc.Invoke(ctx, "/noble.permissions.v1.Query/CircuitBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Policies(context.Context, *QueryPolicies) (*QueryPoliciesResponse, error)
+	Policy(context.Context, *QueryPolicy) (*QueryPolicyResponse, error)
+	ProtectedDenoms(context.Context, *QueryProtectedDenoms) (*QueryProtectedDenomsResponse, error)
+	ProtectedDenom(context.Context, *QueryProtectedDenom) (*QueryProtectedDenomResponse, error)
+	CircuitBreakers(context.Context, *QueryCircuitBreakers) (*QueryCircuitBreakersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Policies(ctx context.Context, req *QueryPolicies) (*QueryPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Policies not implemented")
+}
+func (*UnimplementedQueryServer) Policy(ctx context.Context, req *QueryPolicy) (*QueryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Policy not implemented")
+}
+func (*UnimplementedQueryServer) ProtectedDenoms(ctx context.Context, req *QueryProtectedDenoms) (*QueryProtectedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtectedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) ProtectedDenom(ctx context.Context, req *QueryProtectedDenom) (*QueryProtectedDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtectedDenom not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreakers(ctx context.Context, req *QueryCircuitBreakers) (*QueryCircuitBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreakers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Policies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Policies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.permissions.v1.Query/Policies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Policies(ctx, req.(*QueryPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Policy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Policy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.permissions.v1.Query/Policy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Policy(ctx, req.(*QueryPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtectedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtectedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtectedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.permissions.v1.Query/ProtectedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtectedDenoms(ctx, req.(*QueryProtectedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtectedDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtectedDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtectedDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.permissions.v1.Query/ProtectedDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtectedDenom(ctx, req.(*QueryProtectedDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreakers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.permissions.v1.Query/CircuitBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreakers(ctx, req.(*QueryCircuitBreakers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.permissions.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Policies",
+			Handler:    _Query_Policies_Handler,
+		},
+		{
+			MethodName: "Policy",
+			Handler:    _Query_Policy_Handler,
+		},
+		{
+			MethodName: "ProtectedDenoms",
+			Handler:    _Query_ProtectedDenoms_Handler,
+		},
+		{
+			MethodName: "ProtectedDenom",
+			Handler:    _Query_ProtectedDenom_Handler,
+		},
+		{
+			MethodName: "CircuitBreakers",
+			Handler:    _Query_CircuitBreakers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/permissions/v1/query.proto",
+}
+
+func (m *QueryPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtectedDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtectedDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtectedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtectedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtectedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtectedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtectedDenoms) > 0 {
+		for iNdEx := len(m.ProtectedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtectedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtectedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtectedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtectedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtectedDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtectedDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtectedDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProtectedDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProtectedDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProtectedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtectedDenoms) > 0 {
+		for _, e := range m.ProtectedDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProtectedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProtectedDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProtectedDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCircuitBreakers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, Policy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtectedDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtectedDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtectedDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtectedDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtectedDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtectedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtectedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtectedDenoms = append(m.ProtectedDenoms, ProtectedDenom{})
+			if err := m.ProtectedDenoms[len(m.ProtectedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtectedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtectedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtectedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtectedDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtectedDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtectedDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtectedDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtectedDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)